@@ -3,13 +3,17 @@ package hbc
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/zxinuoke/hbc-sdk/utils"
 	"github.com/zxinuoke/hbc-sdk/utils/base58"
+	"gopkg.in/yaml.v2"
 )
 
 func TestHbcAddress(t *testing.T) {
@@ -112,3 +116,165 @@ func TestPubKeySecp256k1Address(t *testing.T) {
 		assert.Equal(t, addr, addrB, "Expected addresses to match")
 	}
 }
+
+func TestPubKeyString(t *testing.T) {
+	var pubs []crypto.PubKey
+	var privs [][]byte
+	for _, d := range []string{"01ee5aa673f63fc906fb2dbc191438217c5e3f2646381b5e261be2d1f8479086", "1f118af86fcab84f1a7d5204e0cdda351dcf759498e3550a858b56fc719f5521"} {
+		prikeyByte, _ := hex.DecodeString(d)
+		privs = append(privs, prikeyByte)
+		pubs = append(pubs, SecpPrivKeyGen(prikeyByte).PubKey())
+	}
+
+	pubStr := utils.PubkeyToString(pubs[0])
+	pub, err := utils.PubKeyFromString(pubStr)
+	require.Nil(t, err)
+	require.True(t, pubs[0].Equals(pub))
+
+	bech, err := utils.Bech32ifyAccPub(pubs[0])
+	require.Nil(t, err)
+	require.Equal(t, utils.Bech32PrefixAccPub, bech[:len(utils.Bech32PrefixAccPub)])
+	pub, err = utils.GetAccPubKeyBech32(bech)
+	require.Nil(t, err)
+	require.True(t, pubs[0].Equals(pub))
+	_, err = utils.GetPubKeyFromBech32(utils.Bech32PrefixValPub, bech)
+	require.NotNil(t, err)
+
+	address, _, _ := CreateAddress(privs[0])
+	for _, s := range []string{pubStr, bech} {
+		addr, err := utils.CUAddressFromPubKeyString(s)
+		require.Nil(t, err)
+		require.Equal(t, address, addr.String())
+	}
+
+	mpk := multisig.NewPubKeyMultisigThreshold(2, pubs)
+	pub, err = utils.ParsePubKey(utils.PubkeyToString(mpk))
+	require.Nil(t, err)
+	require.True(t, mpk.Equals(pub))
+	mulAddress, _, _ := GetMultiAddress(privs)
+	require.Equal(t, mulAddress, utils.CUAddressFromPubKey(pub).String())
+	_, err = utils.Bech32ifyAccPub(mpk)
+	require.NotNil(t, err)
+
+	_, err = utils.ParsePubKey("BHPubKey:0OIl")
+	require.NotNil(t, err)
+}
+
+func TestValAndConsAddress(t *testing.T) {
+	prikeyByte, _ := hex.DecodeString("da0bbe0acb8aae423de68a1a59379512d9d92ed453743592d6c3b2bc04252640")
+	pub := SecpPrivKeyGen(prikeyByte).PubKey()
+	cuAddr := utils.CUAddressFromPubKey(pub)
+
+	valAddr := utils.ValAddressFromCUAddress(cuAddr)
+	require.True(t, valAddr.Equals(utils.ValAddressFromPubKey(pub)))
+	require.True(t, valAddr.Equals(cuAddr))
+	require.Equal(t, utils.Bech32PrefixValAddr+"1", valAddr.String()[:len(utils.Bech32PrefixValAddr)+1])
+
+	parsed, err := utils.ValAddressFromBech32(valAddr.String())
+	require.Nil(t, err)
+	require.Equal(t, valAddr, parsed)
+	_, err = utils.ConsAddressFromBech32(valAddr.String())
+	require.NotNil(t, err)
+
+	var res utils.ValAddress
+	testMarshal(t, &valAddr, &res, valAddr.MarshalJSON, (&res).UnmarshalJSON)
+	require.Equal(t, valAddr, res)
+
+	consAddr, err := utils.ConsAddressFromHex(hex.EncodeToString(pub.Address()))
+	require.Nil(t, err)
+	require.True(t, consAddr.Equals(utils.GetConsAddress(pub)))
+	require.Equal(t, utils.Bech32PrefixConsAddr+"1", consAddr.String()[:len(utils.Bech32PrefixConsAddr)+1])
+
+	var resCons utils.ConsAddress
+	testMarshal(t, &consAddr, &resCons, consAddr.MarshalJSON, (&resCons).UnmarshalJSON)
+	require.Equal(t, consAddr, resCons)
+
+	yml, err := yaml.Marshal(struct{ Addr utils.ConsAddress }{consAddr})
+	require.Nil(t, err)
+	require.Contains(t, string(yml), consAddr.String())
+
+	var block BlockData
+	block.Block.Header.ProposerAddress = strings.ToUpper(hex.EncodeToString(pub.Address()))
+	proposer, err := block.ProposerConsAddress()
+	require.Nil(t, err)
+	require.Equal(t, consAddr, proposer)
+}
+
+func TestAddrPrefix(t *testing.T) {
+	hbcPrefix, err := base58.NewAddrPrefix("HBC")
+	require.Nil(t, err)
+	require.Equal(t, base58.DefaultAddrPrefix, hbcPrefix)
+	require.Equal(t, []byte{2, 16, 66}, hbcPrefix.Bytes)
+
+	_, err = base58.NewAddrPrefix("H0C")
+	require.NotNil(t, err)
+	_, err = base58.NewAddrPrefix("")
+	require.NotNil(t, err)
+
+	cuAddr, err := utils.CUAddressFromBase58("HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU")
+	require.Nil(t, err)
+
+	for _, prefix := range []string{"X", "BHB", "1A", "zz", "hbtc"} {
+		p, err := base58.NewAddrPrefix(prefix)
+		require.Nil(t, err)
+
+		s := cuAddr.StringWithPrefix(p)
+		require.True(t, strings.HasPrefix(s, prefix), s)
+		parsed, err := utils.CUAddressFromBase58WithPrefix(s, p)
+		require.Nil(t, err)
+		require.Equal(t, cuAddr, parsed)
+
+		_, err = utils.CUAddressFromBase58(s)
+		require.NotNil(t, err)
+	}
+
+	// the process wide prefix is in use, it does not change anymore
+	require.Equal(t, base58.DefaultAddrPrefix, base58.CurrentAddrPrefix())
+	require.NotNil(t, utils.InitAddrPrefix("BHB"))
+	require.NotNil(t, utils.InitAddrPrefix("0x"))
+	require.Equal(t, base58.DefaultAddrPrefix, base58.CurrentAddrPrefix())
+	require.Equal(t, "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU", cuAddr.String())
+}
+
+func TestValidateAddr(t *testing.T) {
+	valid := "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU"
+	require.Nil(t, utils.ValidateAddr(valid))
+
+	addrErr := func(address string) *utils.AddrError {
+		err := utils.ValidateAddr(address)
+		require.NotNil(t, err, address)
+		e, ok := err.(*utils.AddrError)
+		require.True(t, ok)
+		return e
+	}
+
+	e := addrErr("HBDb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU")
+	require.Equal(t, utils.AddrErrPrefix, e.Kind)
+	require.Equal(t, utils.AddrErrPrefix, addrErr("").Kind)
+
+	e = addrErr("HBCb1bg1Y2qxRhVQ0UxHE7nWcuKzbM7scrwU")
+	require.Equal(t, utils.AddrErrCharacter, e.Kind)
+	require.Equal(t, 16, e.Position)
+	require.Equal(t, '0', e.Char)
+	require.Contains(t, e.Error(), "position 16")
+
+	e = addrErr("HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrw")
+	require.Equal(t, utils.AddrErrLength, e.Kind)
+	require.Equal(t, 27, e.ExpectedLength)
+	require.False(t, utils.IsValidAddr("HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrw"))
+
+	// substitution
+	e = addrErr("HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM8scrwU")
+	require.Equal(t, utils.AddrErrChecksum, e.Kind)
+	require.Contains(t, e.Suggestions, valid)
+	require.Contains(t, e.Error(), valid)
+
+	// transposition
+	e = addrErr("HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7srcwU")
+	require.Equal(t, utils.AddrErrChecksum, e.Kind)
+	require.Contains(t, e.Suggestions, valid)
+
+	ok, err := CheckAddrValid("HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM8scrwU")
+	require.False(t, ok)
+	require.Contains(t, err.Error(), "checksum mismatch")
+}
@@ -9,9 +9,14 @@ import (
 var oracleUrl = "https://explorer.hbtcchain.io/api/v1/default_fee"
 
 func GetHbcGas() (*HbcGas, error) {
+	return GetHbcGasFrom(oracleUrl)
+}
+
+// GetHbcGasFrom reads the default fee and gas from the explorer oracle at url.
+func GetHbcGasFrom(url string) (*HbcGas, error) {
 	var result HbcGas
 
-	err := httpGet(oracleUrl, &result)
+	err := httpGet(url, &result)
 	if err != nil {
 		return nil, err
 	}
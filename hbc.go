@@ -19,8 +19,14 @@ import (
 
 type Hbc struct {
 	RestUrl string
+	Network Network
+	// SkipChainIDCheck makes SendSignedTx broadcast transactions without checking that they
+	// are signed for the network of the node, see CheckSignedTxChainID.
+	SkipChainIDCheck bool
 }
 
+// defaults of the package level transaction functions, clients and TxBuilders carry their own
+// Network instead, see DefaultNetwork.
 var (
 	DefaultDecimals = 18
 	DefaultGasLimit = 2000000
@@ -38,6 +44,7 @@ func NewHbc(url, restPort string) (*Hbc, error) {
 
 	return &Hbc{
 		RestUrl: restUrl,
+		Network: DefaultNetwork(),
 	}, nil
 }
 
@@ -48,6 +55,7 @@ func NewHbcClient(url string) (*Hbc, error) {
 
 	return &Hbc{
 		RestUrl: url,
+		Network: DefaultNetwork(),
 	}, nil
 }
 
@@ -71,6 +79,45 @@ func (hbc *Hbc) GetBlockData(height int64) (*BlockData, error) {
 	return &response, nil
 }
 
+// GetValidatorSet returns the consensus validators at height, 0 means the latest height.
+func (hbc *Hbc) GetValidatorSet(height int64) (*ValidatorSetData, error) {
+	path := "/validatorsets/latest"
+	if height > 0 {
+		path = "/validatorsets/" + strconv.FormatInt(height, 10)
+	}
+
+	var response ValidatorSetData
+	err := hbc.RequestHbcData("GET", path, map[string]interface {
+	}{}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetBlockProposer returns the consensus validator which proposed the block at height.
+func (hbc *Hbc) GetBlockProposer(height int64) (*ValidatorSetItem, error) {
+	blockData, err := hbc.GetBlockData(height)
+	if err != nil {
+		return nil, err
+	}
+	proposer, err := blockData.ProposerConsAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	validatorSet, err := hbc.GetValidatorSet(height)
+	if err != nil {
+		return nil, err
+	}
+	for _, validator := range validatorSet.Result.Validators {
+		if validator.Address.Equals(proposer) {
+			return &validator, nil
+		}
+	}
+	return nil, fmt.Errorf("proposer %v not found in validator set", proposer)
+}
+
 func (hbc *Hbc) GetTransactionData(hash string) (*TxData, error) {
 	var response TxData
 	err := hbc.RequestHbcData("GET", "/txs/"+hash, map[string]interface {
@@ -91,28 +138,51 @@ func (hbc *Hbc) GetAddressInfo(address string) (*AddressData, error) {
 	return &response, nil
 }
 
+// GetCoinBalance returns the available balance of coin, "0" when the address holds none.
 func (hbc *Hbc) GetCoinBalance(address, coin string) (string, error) {
 	if coin == "" {
-		coin = "hbc"
+		coin = hbc.network().FeeDenom
 	}
 
-	var response AssetData
-	err := hbc.RequestHbcData("GET", "/transfer/balances/"+address, map[string]interface {
-	}{}, &response)
+	balances, err := hbc.GetBalances(address)
 	if err != nil {
 		return "", err
 	}
 
-	for _, value := range response.Result.Available {
-		if value.Denom == coin {
-			return value.Amount, nil
-		}
+	return balances.AvailableOf(coin).String(), nil
+}
+
+// GetBalances returns the balances of every denom held by address.
+func (hbc *Hbc) GetBalances(address string) (*Balances, error) {
+	return hbc.GetBalancesAtHeight(address, 0)
+}
+
+// GetBalancesAtHeight returns the balances of address at a historical height, 0 means the latest height.
+func (hbc *Hbc) GetBalancesAtHeight(address string, height int64) (*Balances, error) {
+	args := map[string]interface{}{}
+	if height > 0 {
+		args["height"] = strconv.FormatInt(height, 10)
 	}
 
-	return "0", errors.New("can not find balance")
+	var response ResultResponse
+	err := hbc.RequestHbcData("GET", "/transfer/balances/"+address, args, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var balances Balances
+	err = json.Unmarshal(response.Result, &balances)
+	if err != nil {
+		return nil, err
+	}
+	balances.Height, _ = response.Height.Int64()
+	balances.Available = balances.Available.Sort()
+	balances.Locked = balances.Locked.Sort()
+
+	return &balances, nil
 }
 
-func createUnsignData(tokenId, fromAddress, toAddress, memo string, amount, fee string, sequence int64) (*tx.StdSignMsg, error) {
+func (b *TxBuilder) createUnsignData(tokenId, fromAddress, toAddress, memo string, amount, fee string, sequence int64) (*tx.StdSignMsg, error) {
 	addr1, err := utils.CUAddressFromBase58(fromAddress)
 	if err != nil {
 		return nil, err
@@ -128,27 +198,95 @@ func createUnsignData(tokenId, fromAddress, toAddress, memo string, amount, fee
 	coins := utils.NewCoins(utils.NewCoin(tokenId, amountBigInt))
 	msg := utils.NewMsgSend(addr1, addr2, coins)
 
-	feeBigInt, ok := sdk.NewIntFromString(fee)
-	if !ok {
-		return nil, errors.New("error send fee")
+	return b.createUnsignMsgsData([]utils.Msg{msg}, memo, fee, sequence)
+}
+
+// TxParams are the chain parameters transactions are built with.
+type TxParams struct {
+	ChainID  string
+	FeeDenom string
+	MinFee   sdk.Int
+	GasLimit uint64
+}
+
+// DefaultTxParams returns the TxParams of the package defaults.
+func DefaultTxParams() (TxParams, error) {
+	return DefaultNetwork().TxParams()
+}
+
+// GetTxParams returns the TxParams of the client network with the network of the node as chain
+// id. The fee and gas limit are raised to the ones of the network fee oracle, if any, and the fee
+// to the minimum gas prices of the node for the gas limit, but never go below the network MinFee
+// and GasLimit.
+func (hbc *Hbc) GetTxParams() (*TxParams, error) {
+	network := hbc.network()
+	params, err := network.TxParams()
+	if err != nil {
+		return nil, err
 	}
 
-	defaultFeeBigInt, ok := sdk.NewIntFromString(DefaultFee)
-	if !ok {
-		return nil, errors.New("error  defaultFeeBigInt")
+	params.ChainID, err = hbc.GetNetwork()
+	if err != nil {
+		return nil, err
+	}
+
+	if network.FeeOracle != "" {
+		gas, err := GetHbcGasFrom(network.FeeOracle)
+		if err != nil {
+			return nil, err
+		}
+		if fee, ok := sdk.NewIntFromString(gas.Fee); ok && fee.GT(params.MinFee) {
+			params.MinFee = fee
+		}
+		if gasLimit, err := strconv.ParseUint(gas.Gas, 10, 64); err == nil && gasLimit > params.GasLimit {
+			params.GasLimit = gasLimit
+		}
 	}
-	if feeBigInt.LT(defaultFeeBigInt) {
-		feeBigInt = defaultFeeBigInt
+
+	prices, err := hbc.GetMinGasPrices()
+	if err != nil {
+		return nil, err
+	}
+	if price := prices.AmountOf(params.FeeDenom); price.IsPositive() {
+		fee := price.MulInt64(int64(params.GasLimit)).Ceil().TruncateInt()
+		if fee.GT(params.MinFee) {
+			params.MinFee = fee
+		}
 	}
 
-	feecoins := sdk.NewCoins(sdk.NewCoin(DefaultTokenId, feeBigInt))
-	feeData := tx.NewStdFee(uint64(DefaultGasLimit), feecoins)
+	return &params, nil
+}
+
+func (b *TxBuilder) createUnsignMsgsData(msgs []utils.Msg, memo string, fee string, sequence int64) (*tx.StdSignMsg, error) {
+	params, err := b.txParams()
+	if err != nil {
+		return nil, err
+	}
+	if err := b.Network.checkTxAddrPrefix(); err != nil {
+		return nil, err
+	}
+
+	return createUnsignMsgsDataWithParams(params, msgs, memo, fee, sequence)
+}
+
+func createUnsignMsgsDataWithParams(params TxParams, msgs []utils.Msg, memo string, fee string, sequence int64) (*tx.StdSignMsg, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("no msgs")
+	}
+
+	feeBigInt, ok := sdk.NewIntFromString(fee)
+	if !ok {
+		return nil, errors.New("error send fee")
+	}
+	if feeBigInt.LT(params.MinFee) {
+		feeBigInt = params.MinFee
+	}
 
-	var msgs []utils.Msg
-	msgs = append(msgs, msg)
+	feecoins := sdk.NewCoins(sdk.NewCoin(params.FeeDenom, feeBigInt))
+	feeData := tx.NewStdFee(params.GasLimit, feecoins)
 
 	signMsg := tx.StdSignMsg{
-		ChainID:  DefaultChainID,
+		ChainID:  params.ChainID,
 		Sequence: sequence,
 		Memo:     memo,
 		Msgs:     msgs,
@@ -164,10 +302,45 @@ func createUnsignData(tokenId, fromAddress, toAddress, memo string, amount, fee
 }
 
 func CreateTransaction(tokenId string, fromPriKey []byte, fromAddress, toAddress, memo string, amount, fee string, sequence int64) ([]byte, error) {
-	signMsg, err := createUnsignData(tokenId, fromAddress, toAddress, memo, amount, fee, sequence)
+	return DefaultTxBuilder().CreateTransaction(tokenId, fromPriKey, fromAddress, toAddress, memo, amount, fee, sequence)
+}
+
+func (b *TxBuilder) CreateTransaction(tokenId string, fromPriKey []byte, fromAddress, toAddress, memo string, amount, fee string, sequence int64) ([]byte, error) {
+	signMsg, err := b.createUnsignData(tokenId, fromAddress, toAddress, memo, amount, fee, sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return signTransaction(signMsg, fromPriKey)
+}
+
+// CreateMsgsTransaction signs a transaction carrying msgs, e.g. staking msgs.
+func CreateMsgsTransaction(fromPriKey []byte, msgs []utils.Msg, memo, fee string, sequence int64) ([]byte, error) {
+	return DefaultTxBuilder().CreateMsgsTransaction(fromPriKey, msgs, memo, fee, sequence)
+}
+
+// CreateMsgsTransaction signs a transaction carrying msgs, e.g. staking msgs.
+func (b *TxBuilder) CreateMsgsTransaction(fromPriKey []byte, msgs []utils.Msg, memo, fee string, sequence int64) ([]byte, error) {
+	signMsg, err := b.createUnsignMsgsData(msgs, memo, fee, sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return signTransaction(signMsg, fromPriKey)
+}
+
+// CreateMsgsTransactionWithParams signs a transaction carrying msgs with params, e.g. read from
+// the chain by GetTxParams, instead of the package defaults.
+func CreateMsgsTransactionWithParams(params TxParams, fromPriKey []byte, msgs []utils.Msg, memo, fee string, sequence int64) ([]byte, error) {
+	signMsg, err := createUnsignMsgsDataWithParams(params, msgs, memo, fee, sequence)
 	if err != nil {
 		return nil, err
 	}
+
+	return signTransaction(signMsg, fromPriKey)
+}
+
+func signTransaction(signMsg *tx.StdSignMsg, fromPriKey []byte) ([]byte, error) {
 	priv := SecpPrivKeyGen(fromPriKey)
 
 	signData, err := priv.Sign(signMsg.Bytes())
@@ -185,9 +358,72 @@ func CreateTransaction(tokenId string, fromPriKey []byte, fromAddress, toAddress
 		Signature: signData,
 	}
 
-	stdTx := tx.NewStdTx(signMsg.Msgs, []tx.StdSignature{sig}, signMsg.Memo, signMsg.Fee)
+	return encodeSignedTx(signMsg, []tx.StdSignature{sig})
+}
+
+// CreateTransactionByName signs a send transaction with the key name of the keyring.
+func CreateTransactionByName(kr Keyring, name string, tokenId, toAddress, memo string, amount, fee string, sequence int64) ([]byte, error) {
+	return DefaultTxBuilder().CreateTransactionByName(kr, name, tokenId, toAddress, memo, amount, fee, sequence)
+}
+
+// CreateTransactionByName signs a send transaction with the key name of the keyring.
+func (b *TxBuilder) CreateTransactionByName(kr Keyring, name string, tokenId, toAddress, memo string, amount, fee string, sequence int64) ([]byte, error) {
+	info, err := kr.Show(name)
+	if err != nil {
+		return nil, err
+	}
 
-	err = stdTx.ValidateBasic()
+	signMsg, err := b.createUnsignData(tokenId, info.Address, toAddress, memo, amount, fee, sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return signTransactionByName(signMsg, kr, name)
+}
+
+// CreateMsgsTransactionByName signs a transaction carrying msgs with the key name of the keyring.
+func CreateMsgsTransactionByName(kr Keyring, name string, msgs []utils.Msg, memo, fee string, sequence int64) ([]byte, error) {
+	return DefaultTxBuilder().CreateMsgsTransactionByName(kr, name, msgs, memo, fee, sequence)
+}
+
+// CreateMsgsTransactionByName signs a transaction carrying msgs with the key name of the keyring.
+func (b *TxBuilder) CreateMsgsTransactionByName(kr Keyring, name string, msgs []utils.Msg, memo, fee string, sequence int64) ([]byte, error) {
+	signMsg, err := b.createUnsignMsgsData(msgs, memo, fee, sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return signTransactionByName(signMsg, kr, name)
+}
+
+// CreateMsgsTransactionWithParamsByName is CreateMsgsTransactionWithParams signing with the key name of the keyring.
+func CreateMsgsTransactionWithParamsByName(params TxParams, kr Keyring, name string, msgs []utils.Msg, memo, fee string, sequence int64) ([]byte, error) {
+	signMsg, err := createUnsignMsgsDataWithParams(params, msgs, memo, fee, sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return signTransactionByName(signMsg, kr, name)
+}
+
+func signTransactionByName(signMsg *tx.StdSignMsg, kr Keyring, name string) ([]byte, error) {
+	signData, pub, err := kr.Sign(name, signMsg.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	sig := tx.StdSignature{
+		PubKey:    pub,
+		Signature: signData,
+	}
+
+	return encodeSignedTx(signMsg, []tx.StdSignature{sig})
+}
+
+func encodeSignedTx(signMsg *tx.StdSignMsg, sigs []tx.StdSignature) ([]byte, error) {
+	stdTx := tx.NewStdTx(signMsg.Msgs, sigs, signMsg.Memo, signMsg.Fee)
+
+	err := stdTx.ValidateBasic()
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +439,11 @@ func CreateTransaction(tokenId string, fromPriKey []byte, fromAddress, toAddress
 }
 
 func CreateMultiTransaction(tokenId string, fromPriKey []byte, pubkeys []crypto.PubKey, fromAddress, toAddress, memo string, amount, fee string, sequence int64) ([]byte, error) {
-	signMsg, err := createUnsignData(tokenId, fromAddress, toAddress, memo, amount, fee, sequence)
+	return DefaultTxBuilder().CreateMultiTransaction(tokenId, fromPriKey, pubkeys, fromAddress, toAddress, memo, amount, fee, sequence)
+}
+
+func (b *TxBuilder) CreateMultiTransaction(tokenId string, fromPriKey []byte, pubkeys []crypto.PubKey, fromAddress, toAddress, memo string, amount, fee string, sequence int64) ([]byte, error) {
+	signMsg, err := b.createUnsignData(tokenId, fromAddress, toAddress, memo, amount, fee, sequence)
 	if err != nil {
 		return nil, err
 	}
@@ -238,6 +478,10 @@ func CreateMultiTransaction(tokenId string, fromPriKey []byte, pubkeys []crypto.
 }
 
 func MergeMultiSign(tokenId string, txData []byte, fromPriKey []byte, pubkeys []crypto.PubKey, fromAddress, toAddress, memo string, amount, fee string, sequence int64) ([]byte, error) {
+	return DefaultTxBuilder().MergeMultiSign(tokenId, txData, fromPriKey, pubkeys, fromAddress, toAddress, memo, amount, fee, sequence)
+}
+
+func (b *TxBuilder) MergeMultiSign(tokenId string, txData []byte, fromPriKey []byte, pubkeys []crypto.PubKey, fromAddress, toAddress, memo string, amount, fee string, sequence int64) ([]byte, error) {
 	var multisigSig multisig.Multisignature
 
 	err := json.Unmarshal(txData, &multisigSig)
@@ -245,7 +489,7 @@ func MergeMultiSign(tokenId string, txData []byte, fromPriKey []byte, pubkeys []
 		return nil, err
 	}
 
-	signMsg, err := createUnsignData(tokenId, fromAddress, toAddress, memo, amount, fee, sequence)
+	signMsg, err := b.createUnsignData(tokenId, fromAddress, toAddress, memo, amount, fee, sequence)
 	if err != nil {
 		return nil, err
 	}
@@ -275,23 +519,31 @@ func MergeMultiSign(tokenId string, txData []byte, fromPriKey []byte, pubkeys []
 	}
 
 	sig := tx.StdSignature{PubKey: mpk, Signature: multisigSig.Marshal()}
-	stdTx := tx.NewStdTx(signMsg.Msgs, []tx.StdSignature{sig}, signMsg.Memo, signMsg.Fee)
-	err = stdTx.ValidateBasic()
-	if err != nil {
-		return nil, err
-	}
 
-	sendData := tx.SendData{Tx: stdTx, Mode: "sync"}
+	return encodeSignedTx(signMsg, []tx.StdSignature{sig})
+}
 
-	bz, err := tx.Cdc.MarshalJSON(&sendData)
+// queryResult requests an LCD query route and decodes the result of the response into result.
+func (hbc *Hbc) queryResult(requestPath string, args map[string]interface{}, result interface{}) error {
+	var response ResultResponse
+	err := hbc.RequestHbcData("GET", requestPath, args, &response)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if len(response.Result) == 0 {
+		return fmt.Errorf("empty result of %v", requestPath)
 	}
 
-	return bz, err
+	return json.Unmarshal(response.Result, result)
 }
 
 func (hbc *Hbc) SendSignedTx(txData []byte) (string, error) {
+	if !hbc.SkipChainIDCheck {
+		if err := hbc.CheckSignedTxChainID(txData); err != nil {
+			return "", err
+		}
+	}
+
 	var response TxResponse
 
 	err := hbc.PostHbcData("/txs", txData, &response)
@@ -331,7 +583,7 @@ func (hbc *Hbc) PostHbcData(requestPath string, postData []byte, model interface
 	errResp := &BaseResponse{}
 	err = json.Unmarshal(bytes, errResp)
 	if nil == err && errResp.Error != "" {
-		return fmt.Errorf("http request err:%v", stringBody)
+		return &RequestError{StatusCode: resp.StatusCode, Body: stringBody}
 	}
 
 	err = json.Unmarshal(bytes, &model)
@@ -389,7 +641,7 @@ func (hbc *Hbc) RequestHbcData(method, requestPath string, args map[string]inter
 	errResp := &BaseResponse{}
 	err = json.Unmarshal(bytes, errResp)
 	if nil == err && errResp.Error != "" {
-		return fmt.Errorf("http request err:%v", string(bytes))
+		return &RequestError{StatusCode: resp.StatusCode, Body: string(bytes)}
 	}
 
 	err = json.Unmarshal(bytes, &model)
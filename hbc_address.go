@@ -77,8 +77,9 @@ func GetMultiPubs(pks []byte) ([]crypto.PubKey, error) {
 	return mpks.PubKeys, nil
 }
 
+// CheckAddrValid checks the address, the error is a *utils.AddrError telling why it is invalid.
 func CheckAddrValid(addr string) (bool, error) {
-	_, err := utils.CUAddressFromBase58(addr)
+	err := utils.ValidateAddr(addr)
 	if err != nil {
 		return false, err
 	}
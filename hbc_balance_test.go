@@ -0,0 +1,34 @@
+package hbc
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBalances(t *testing.T) {
+	address := "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU"
+	client, closer := newTestLCD(t, map[string]string{
+		"/transfer/balances/" + address: `{"height":"646737","result":{"available":[{"denom":"hbc","amount":"1000000000000000000000"},{"denom":"btc","amount":"5"}],"locked":[{"denom":"hbc","amount":"20"}]}}`,
+	})
+	defer closer()
+
+	balances, err := client.GetBalancesAtHeight(address, 646737)
+	require.Nil(t, err)
+	require.Equal(t, int64(646737), balances.Height)
+	require.Equal(t, "btc", balances.Available[0].Denom)
+
+	amount, _ := sdk.NewIntFromString("1000000000000000000000")
+	require.Equal(t, amount, balances.AvailableOf("hbc"))
+	require.Equal(t, sdk.NewInt(20), balances.LockedOf("hbc"))
+	require.Equal(t, sdk.ZeroInt(), balances.LockedOf("btc"))
+	require.Equal(t, amount.AddRaw(20), balances.Total().AmountOf("hbc"))
+
+	balance, err := client.GetCoinBalance(address, "eth")
+	require.Nil(t, err)
+	require.Equal(t, "0", balance)
+	balance, err = client.GetCoinBalance(address, "")
+	require.Nil(t, err)
+	require.Equal(t, amount.String(), balance)
+}
@@ -0,0 +1,119 @@
+package hbc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+// ErrCUNotFound is returned by GetCU for an address without CU on the chain.
+var ErrCUNotFound = errors.New("cu not found")
+
+// CUAsset is the deposit address of a CU for a token of an external chain.
+type CUAsset struct {
+	Chain        string `json:"chain"`
+	Symbol       string `json:"symbol"`
+	Address      string `json:"address"`
+	Nonce        uint64 `json:"nonce,string"`
+	EnableSendTx bool   `json:"enable_sendtx"`
+}
+
+// CU is a custodian unit, the account model of the chain.
+type CU struct {
+	Type           utils.CUType    `json:"cu_type"`
+	Address        utils.CUAddress `json:"address"`
+	PubKey         json.RawMessage `json:"public_key"`
+	AccountNumber  uint64          `json:"account_number,string"`
+	Sequence       uint64          `json:"sequence,string"`
+	Coins          utils.Coins     `json:"coins"`
+	CoinsHold      utils.Coins     `json:"coins_hold"`
+	AssetCoins     utils.Coins     `json:"asset_coins"`
+	AssetCoinsHold utils.Coins     `json:"asset_coins_hold"`
+	GasUsed        utils.Coins     `json:"gas_used"`
+	GasReceived    utils.Coins     `json:"gas_received"`
+	Assets         []CUAsset       `json:"assets"`
+}
+
+func (cu CU) IsUser() bool { return cu.Type == utils.CUTypeUser }
+
+func (cu CU) IsOp() bool { return cu.Type == utils.CUTypeOp }
+
+func (cu CU) IsOrg() bool { return cu.Type == utils.CUTypeORG }
+
+// GetPubKey decodes the public key of the CU, nil when the CU never signed a transaction.
+func (cu CU) GetPubKey() (crypto.PubKey, error) {
+	if len(cu.PubKey) == 0 || string(cu.PubKey) == "null" {
+		return nil, nil
+	}
+
+	var pubKey crypto.PubKey
+	err := tx.Cdc.UnmarshalJSON(cu.PubKey, &pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
+// GetAsset returns the asset of the CU for symbol on chain, a symbol may be held on several chains.
+func (cu CU) GetAsset(chain, symbol string) (CUAsset, bool) {
+	for _, asset := range cu.Assets {
+		if asset.Chain == chain && asset.Symbol == symbol {
+			return asset, true
+		}
+	}
+	return CUAsset{}, false
+}
+
+// DepositAddress returns the external address of the CU for symbol on chain. Tokens without an
+// own asset entry on chain share the address of the chain.
+func (cu CU) DepositAddress(chain, symbol string) (string, bool) {
+	if asset, ok := cu.GetAsset(chain, symbol); ok && asset.Address != "" {
+		return asset.Address, true
+	}
+	if asset, ok := cu.GetAsset(chain, chain); ok && asset.Address != "" {
+		return asset.Address, true
+	}
+	for _, asset := range cu.Assets {
+		if asset.Chain == chain && asset.Address != "" {
+			return asset.Address, true
+		}
+	}
+	return "", false
+}
+
+// AvailableOf returns the spendable amount of denom.
+func (cu CU) AvailableOf(denom string) sdk.Int {
+	return amountOf(cu.Coins, denom)
+}
+
+// LockedOf returns the amount of denom held for pending operations.
+func (cu CU) LockedOf(denom string) sdk.Int {
+	return amountOf(cu.CoinsHold, denom)
+}
+
+// GetCU returns the custodian unit of address.
+func (hbc *Hbc) GetCU(address string) (*CU, error) {
+	var result struct {
+		Type  string `json:"type"`
+		Value CU     `json:"value"`
+	}
+	err := hbc.queryResult("/cu/cus/"+address, map[string]interface {
+	}{}, &result)
+	var reqErr *RequestError
+	if errors.As(err, &reqErr) && reqErr.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %v", ErrCUNotFound, address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if result.Value.Address.Empty() {
+		return nil, fmt.Errorf("%w: %v", ErrCUNotFound, address)
+	}
+	return &result.Value, nil
+}
@@ -0,0 +1,69 @@
+package hbc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func TestGetCU(t *testing.T) {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	address := utils.CUAddressFromPubKey(pubKey).String()
+	pubKeyJSON := tx.Cdc.MustMarshalJSON(pubKey)
+
+	client, closer := newTestLCD(t, map[string]string{
+		"/cu/cus/" + address: fmt.Sprintf(`{"height":"100","result":{"type":"hbtcchain/CustodianUnit","value":{"cu_type":1,"address":"%s","public_key":%s,"account_number":"7","sequence":"12","coins":[{"denom":"hbc","amount":"1000"}],"coins_hold":[{"denom":"hbc","amount":"10"}],"asset_coins":[],"asset_coins_hold":[],"gas_used":[{"denom":"eth","amount":"21000"}],"gas_received":[],"assets":[{"chain":"eth","symbol":"eth","address":"0x81b7e08f65bdf5648606c89998a9cc8164397647","nonce":"3","enable_sendtx":true}]}}}`, address, pubKeyJSON),
+	})
+	defer closer()
+
+	cu, err := client.GetCU(address)
+	require.Nil(t, err)
+	require.True(t, cu.IsUser())
+	require.False(t, cu.IsOp())
+	require.Equal(t, "user", cu.Type.String())
+	require.Equal(t, uint64(7), cu.AccountNumber)
+	require.Equal(t, uint64(12), cu.Sequence)
+	require.Equal(t, int64(1000), cu.AvailableOf("hbc").Int64())
+	require.Equal(t, int64(10), cu.LockedOf("hbc").Int64())
+	require.Equal(t, int64(21000), cu.GasUsed.AmountOf("eth").Int64())
+
+	cuPubKey, err := cu.GetPubKey()
+	require.Nil(t, err)
+	require.True(t, pubKey.Equals(cuPubKey))
+
+	depositAddr, ok := cu.DepositAddress("eth", "usdt")
+	require.True(t, ok)
+	require.Equal(t, "0x81b7e08f65bdf5648606c89998a9cc8164397647", depositAddr)
+	_, ok = cu.DepositAddress("btc", "btc")
+	require.False(t, ok)
+}
+
+func TestCUDepositAddressChains(t *testing.T) {
+	cu := CU{Assets: []CUAsset{
+		{Chain: "trx", Symbol: "usdt", Address: "TJRabPrwbZy45sbavfcjinPJC18kjpRTv8"},
+		{Chain: "eth", Symbol: "usdt", Address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{Chain: "eth", Symbol: "eth", Address: "0x81b7e08f65bdf5648606c89998a9cc8164397647"},
+		{Chain: "btc", Symbol: "btc", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+	}}
+
+	addr, ok := cu.DepositAddress("eth", "usdt")
+	require.True(t, ok)
+	require.Equal(t, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", addr)
+	addr, ok = cu.DepositAddress("trx", "usdt")
+	require.True(t, ok)
+	require.Equal(t, "TJRabPrwbZy45sbavfcjinPJC18kjpRTv8", addr)
+
+	// a token of eth without an own entry shares the eth address
+	addr, ok = cu.DepositAddress("eth", "link")
+	require.True(t, ok)
+	require.Equal(t, "0x81b7e08f65bdf5648606c89998a9cc8164397647", addr)
+
+	_, ok = cu.DepositAddress("bsc", "usdt")
+	require.False(t, ok)
+	_, ok = cu.GetAsset("btc", "usdt")
+	require.False(t, ok)
+}
@@ -2,6 +2,10 @@ package hbc
 
 import (
 	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/zxinuoke/hbc-sdk/utils"
 )
 
 type HbcGas struct {
@@ -13,6 +17,23 @@ type BaseResponse struct {
 	Error string `json:"error"`
 }
 
+// RequestError is an error response of the node.
+type RequestError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *RequestError) Error() string {
+	return "http request err:" + e.Body
+}
+
+// ResultResponse is the envelope of the LCD query routes.
+type ResultResponse struct {
+	BaseResponse
+	Height json.Number     `json:"height"`
+	Result json.RawMessage `json:"result"`
+}
+
 type BlockData struct {
 	BaseResponse
 	Block struct {
@@ -39,6 +60,32 @@ type BlockData struct {
 	} `json:"block"`
 }
 
+// ProposerConsAddress returns the consensus address of the block proposer.
+func (b *BlockData) ProposerConsAddress() (utils.ConsAddress, error) {
+	return utils.ConsAddressFromHex(b.Block.Header.ProposerAddress)
+}
+
+type ValidatorSetData struct {
+	BaseResponse
+	Height json.Number `json:"height"`
+	Result struct {
+		BlockHeight json.Number        `json:"block_height"`
+		Validators  []ValidatorSetItem `json:"validators"`
+	} `json:"result"`
+}
+
+type ValidatorSetItem struct {
+	Address          utils.ConsAddress `json:"address"`
+	PubKey           string            `json:"pub_key"`
+	ProposerPriority json.Number       `json:"proposer_priority"`
+	VotingPower      json.Number       `json:"voting_power"`
+}
+
+// ConsPubKey decodes the bech32 consensus public key of the validator.
+func (v ValidatorSetItem) ConsPubKey() (crypto.PubKey, error) {
+	return utils.GetPubKeyFromBech32(utils.Bech32PrefixConsPub, v.PubKey)
+}
+
 type TxAmount struct {
 	Amount string `json:"amount"`
 	Denom  string `json:"denom"`
@@ -119,3 +166,34 @@ type AssetData struct {
 		} `json:"available"`
 	} `json:"result"`
 }
+
+// Balances holds the balances of an address per category.
+type Balances struct {
+	Height    int64       `json:"-"`
+	Available utils.Coins `json:"available"`
+	Locked    utils.Coins `json:"locked"`
+}
+
+// Total returns the sum of all balance categories.
+func (b Balances) Total() utils.Coins {
+	return b.Available.Add(b.Locked)
+}
+
+// AvailableOf returns the available amount of denom, zero when there is none.
+func (b Balances) AvailableOf(denom string) sdk.Int {
+	return amountOf(b.Available, denom)
+}
+
+// LockedOf returns the locked amount of denom, zero when there is none.
+func (b Balances) LockedOf(denom string) sdk.Int {
+	return amountOf(b.Locked, denom)
+}
+
+func amountOf(coins utils.Coins, denom string) sdk.Int {
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			return coin.Amount
+		}
+	}
+	return sdk.ZeroInt()
+}
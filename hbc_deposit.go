@@ -0,0 +1,224 @@
+package hbc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+type DepositStatus int
+
+const (
+	DepositStatusUnconfirmed DepositStatus = 0x0
+	DepositStatusWaitCollect DepositStatus = 0x1
+	DepositStatusInProcess   DepositStatus = 0x2
+	DepositStatusConfirmed   DepositStatus = 0x3
+)
+
+func (s DepositStatus) String() string {
+	switch s {
+	case DepositStatusUnconfirmed:
+		return "unconfirmed"
+	case DepositStatusWaitCollect:
+		return "wait_collect"
+	case DepositStatusInProcess:
+		return "in_process"
+	case DepositStatusConfirmed:
+		return "confirmed"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// DepositItem is a deposit of an external chain transaction output to a CU.
+type DepositItem struct {
+	Hash       string        `json:"hash"`
+	Index      uint64        `json:"index,string"`
+	Symbol     string        `json:"symbol"`
+	ExtAddress string        `json:"address"`
+	Amount     sdk.Int       `json:"amount"`
+	Memo       string        `json:"memo"`
+	Status     DepositStatus `json:"status"`
+	OrderID    string        `json:"order_id"`
+}
+
+// IsCredited checks if the deposit is finalized and credited to the CU.
+func (d DepositItem) IsCredited() bool {
+	return d.Status == DepositStatusConfirmed
+}
+
+func (d DepositItem) key() string {
+	return fmt.Sprintf("%v:%v:%v", d.Symbol, d.Hash, d.Index)
+}
+
+// DepositOrder is the order confirming a deposit on HBC.
+type DepositOrder struct {
+	OrderID       string          `json:"order_id"`
+	Symbol        string          `json:"symbol"`
+	CUAddress     utils.CUAddress `json:"cu_address"`
+	ExtTxHash     string          `json:"ext_tx_hash"`
+	Amount        sdk.Int         `json:"amount"`
+	Status        OrderStatus     `json:"status"`
+	Confirmations uint64          `json:"confirmations,string"`
+	Height        int64           `json:"height,string"`
+}
+
+func (hbc *Hbc) GetDepositOrder(orderID string) (*DepositOrder, error) {
+	var order DepositOrder
+	err := hbc.queryResult("/order/orders/"+orderID, map[string]interface {
+	}{}, &order)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// GetDeposits returns the deposits of address for symbol.
+func (hbc *Hbc) GetDeposits(address, symbol string) ([]DepositItem, error) {
+	var deposits []DepositItem
+	err := hbc.queryResult("/cu/deposits/"+address, map[string]interface{}{
+		"symbol": symbol,
+	}, &deposits)
+	if err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
+
+// GetPendingDeposits returns the deposits of address for symbol not yet credited.
+func (hbc *Hbc) GetPendingDeposits(address, symbol string) ([]DepositItem, error) {
+	return hbc.filterDeposits(address, symbol, false)
+}
+
+// GetConfirmedDeposits returns the credited deposits of address for symbol.
+func (hbc *Hbc) GetConfirmedDeposits(address, symbol string) ([]DepositItem, error) {
+	return hbc.filterDeposits(address, symbol, true)
+}
+
+func (hbc *Hbc) filterDeposits(address, symbol string, credited bool) ([]DepositItem, error) {
+	deposits, err := hbc.GetDeposits(address, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []DepositItem
+	for _, deposit := range deposits {
+		if deposit.IsCredited() == credited {
+			filtered = append(filtered, deposit)
+		}
+	}
+	return filtered, nil
+}
+
+// DepositEvent is a state change of a deposit, from incoming through its confirmations to
+// credited, or the error of a poll.
+type DepositEvent struct {
+	Deposit DepositItem
+	// Order is the deposit order, nil while the deposit has none.
+	Order *DepositOrder
+	Err   error
+}
+
+// Confirmations returns the confirmations of the deposit order.
+func (e DepositEvent) Confirmations() uint64 {
+	if e.Order == nil {
+		return 0
+	}
+	return e.Order.Confirmations
+}
+
+// IsCredited checks if the deposit of the event is credited to the CU.
+func (e DepositEvent) IsCredited() bool {
+	return e.Deposit.IsCredited()
+}
+
+type depositState struct {
+	status        DepositStatus
+	orderStatus   OrderStatus
+	confirmations uint64
+}
+
+// WatchDeposits polls the deposits of address for symbol every interval and emits an event on
+// every state change of a deposit: incoming, each change of its order status or confirmations,
+// and credited, until ctx is done. Deposits already credited at the first poll are not emitted.
+// An invalid interval is emitted as the error of the only event.
+func (hbc *Hbc) WatchDeposits(ctx context.Context, address, symbol string, interval time.Duration) <-chan DepositEvent {
+	events := make(chan DepositEvent)
+
+	go func() {
+		defer close(events)
+
+		emit := func(event DepositEvent) bool {
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if err := validatePollInterval(interval); err != nil {
+			emit(DepositEvent{Err: err})
+			return
+		}
+
+		var states map[string]depositState
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			deposits, err := hbc.GetDeposits(address, symbol)
+			if err != nil && !emit(DepositEvent{Err: err}) {
+				return
+			}
+
+			initial := states == nil && err == nil
+			if initial {
+				states = map[string]depositState{}
+			}
+			for _, deposit := range deposits {
+				last, seen := states[deposit.key()]
+				if seen && last.status == DepositStatusConfirmed {
+					continue
+				}
+				if initial && deposit.IsCredited() {
+					states[deposit.key()] = depositState{status: deposit.Status}
+					continue
+				}
+
+				event := DepositEvent{Deposit: deposit}
+				if deposit.OrderID != "" {
+					event.Order, err = hbc.GetDepositOrder(deposit.OrderID)
+					if err != nil {
+						if !emit(DepositEvent{Deposit: deposit, Err: err}) {
+							return
+						}
+						continue
+					}
+				}
+
+				state := depositState{status: deposit.Status, confirmations: event.Confirmations()}
+				if event.Order != nil {
+					state.orderStatus = event.Order.Status
+				}
+				if seen && state == last {
+					continue
+				}
+				states[deposit.key()] = state
+				if !emit(event) {
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
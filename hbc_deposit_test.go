@@ -0,0 +1,101 @@
+package hbc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDeposits(t *testing.T) {
+	address := "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU"
+	client, closer := newTestLCD(t, map[string]string{
+		"/cu/deposits/" + address: `{"height":"5","result":[{"hash":"aa","index":"0","symbol":"btc","address":"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2","amount":"100","memo":"","status":0},{"hash":"bb","index":"1","symbol":"btc","address":"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2","amount":"200","memo":"","status":3}]}`,
+	})
+	defer closer()
+
+	pending, err := client.GetPendingDeposits(address, "btc")
+	require.Nil(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, "aa", pending[0].Hash)
+	require.Equal(t, "unconfirmed", pending[0].Status.String())
+
+	confirmed, err := client.GetConfirmedDeposits(address, "btc")
+	require.Nil(t, err)
+	require.Len(t, confirmed, 1)
+	require.Equal(t, uint64(1), confirmed[0].Index)
+	require.Equal(t, int64(200), confirmed[0].Amount.Int64())
+}
+
+func TestWatchDeposits(t *testing.T) {
+	var mtx sync.Mutex
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		switch r.URL.Path {
+		case "/order/orders/o-new":
+			status := OrderStatusPending
+			if polls > 3 {
+				status = OrderStatusFinished
+			}
+			confirmations := polls
+			if confirmations > 3 {
+				confirmations = 3
+			}
+			fmt.Fprintf(w, `{"height":"5","result":{"order_id":"o-new","symbol":"btc","ext_tx_hash":"new","amount":"7","status":%d,"confirmations":"%d"}}`, status, confirmations)
+		default:
+			polls++
+			status := DepositStatusUnconfirmed
+			if polls > 3 {
+				status = DepositStatusConfirmed
+			}
+			fmt.Fprintf(w, `{"height":"5","result":[{"hash":"old","index":"0","symbol":"btc","amount":"1","status":3,"order_id":"o-old"},{"hash":"new","index":"2","symbol":"btc","amount":"7","status":%d,"order_id":"o-new"}]}`, status)
+		}
+	}))
+	defer server.Close()
+	client, err := NewHbcClient(server.URL)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := client.WatchDeposits(ctx, "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU", "btc", time.Millisecond)
+
+	next := func() DepositEvent {
+		select {
+		case event := <-events:
+			require.Nil(t, event.Err)
+			require.Equal(t, "new", event.Deposit.Hash)
+			return event
+		case <-time.After(time.Second):
+			t.Fatal("no deposit event")
+			return DepositEvent{}
+		}
+	}
+
+	// incoming, then each confirmation, then credited
+	for confirmations := uint64(1); confirmations <= 3; confirmations++ {
+		event := next()
+		require.False(t, event.IsCredited())
+		require.Equal(t, confirmations, event.Confirmations())
+		require.Equal(t, OrderStatusPending, event.Order.Status)
+	}
+	event := next()
+	require.True(t, event.IsCredited())
+	require.Equal(t, uint64(2), event.Deposit.Index)
+	require.Equal(t, OrderStatusFinished, event.Order.Status)
+
+	cancel()
+	for event := range events {
+		require.NotEqual(t, "old", event.Deposit.Hash)
+		require.False(t, event.IsCredited())
+	}
+
+	invalid := <-client.WatchDeposits(context.Background(), "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU", "btc", 0)
+	require.NotNil(t, invalid.Err)
+}
@@ -0,0 +1,141 @@
+package hbc
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+type DelegationDelegatorReward struct {
+	ValidatorAddress utils.ValAddress `json:"validator_address"`
+	Reward           sdk.DecCoins     `json:"reward"`
+}
+
+type DelegatorTotalRewards struct {
+	Rewards []DelegationDelegatorReward `json:"rewards"`
+	Total   sdk.DecCoins                `json:"total"`
+}
+
+type ValidatorDistInfo struct {
+	OperatorAddress     string       `json:"operator_address"`
+	SelfBondRewards     sdk.DecCoins `json:"self_bond_rewards"`
+	ValidatorCommission sdk.DecCoins `json:"val_commission"`
+}
+
+// GetDelegatorRewards returns the rewards of every delegation of the delegator.
+func (hbc *Hbc) GetDelegatorRewards(delegatorAddr string) (*DelegatorTotalRewards, error) {
+	var rewards DelegatorTotalRewards
+	err := hbc.queryResult("/distribution/delegators/"+delegatorAddr+"/rewards", map[string]interface {
+	}{}, &rewards)
+	if err != nil {
+		return nil, err
+	}
+	return &rewards, nil
+}
+
+// GetDelegationRewards returns the rewards of the delegation to one validator.
+func (hbc *Hbc) GetDelegationRewards(delegatorAddr, validatorAddr string) (sdk.DecCoins, error) {
+	var rewards sdk.DecCoins
+	err := hbc.queryResult("/distribution/delegators/"+delegatorAddr+"/rewards/"+validatorAddr, map[string]interface {
+	}{}, &rewards)
+	if err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
+
+func (hbc *Hbc) GetWithdrawAddress(delegatorAddr string) (utils.CUAddress, error) {
+	var addr utils.CUAddress
+	err := hbc.queryResult("/distribution/delegators/"+delegatorAddr+"/withdraw_address", map[string]interface {
+	}{}, &addr)
+	if err != nil {
+		return nil, err
+	}
+	return addr, nil
+}
+
+func (hbc *Hbc) GetValidatorDistInfo(validatorAddr string) (*ValidatorDistInfo, error) {
+	var info ValidatorDistInfo
+	err := hbc.queryResult("/distribution/validators/"+validatorAddr, map[string]interface {
+	}{}, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// GetValidatorCommission returns the accumulated commission of the validator.
+func (hbc *Hbc) GetValidatorCommission(validatorAddr string) (sdk.DecCoins, error) {
+	info, err := hbc.GetValidatorDistInfo(validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	return info.ValidatorCommission, nil
+}
+
+func (hbc *Hbc) GetValidatorOutstandingRewards(validatorAddr string) (sdk.DecCoins, error) {
+	var rewards sdk.DecCoins
+	err := hbc.queryResult("/distribution/validators/"+validatorAddr+"/outstanding_rewards", map[string]interface {
+	}{}, &rewards)
+	if err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
+
+func (hbc *Hbc) GetCommunityPool() (sdk.DecCoins, error) {
+	var pool sdk.DecCoins
+	err := hbc.queryResult("/distribution/community_pool", map[string]interface {
+	}{}, &pool)
+	if err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// GetWithdrawAllRewardsMsgs returns a withdraw msg for every validator the delegator has rewards from.
+func (hbc *Hbc) GetWithdrawAllRewardsMsgs(delegatorAddr string) ([]utils.Msg, error) {
+	delAddr, err := utils.CUAddressFromBase58(delegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := hbc.GetDelegatorRewards(delegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []utils.Msg
+	for _, reward := range rewards.Rewards {
+		msgs = append(msgs, utils.NewMsgWithdrawDelegatorReward(delAddr, reward.ValidatorAddress))
+	}
+	if len(msgs) == 0 {
+		return nil, errors.New("no rewards to withdraw")
+	}
+	return msgs, nil
+}
+
+// CreateWithdrawAllRewardsTransaction signs a transaction withdrawing the rewards of every delegation.
+func (hbc *Hbc) CreateWithdrawAllRewardsTransaction(fromPriKey []byte, delegatorAddr, memo, fee string, sequence int64) ([]byte, error) {
+	msgs, err := hbc.GetWithdrawAllRewardsMsgs(delegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	return hbc.TxBuilder().CreateMsgsTransaction(fromPriKey, msgs, memo, fee, sequence)
+}
+
+// CreateWithdrawAllRewardsTransactionByName signs a transaction withdrawing the rewards of
+// every delegation of the key name of the keyring.
+func (hbc *Hbc) CreateWithdrawAllRewardsTransactionByName(kr Keyring, name, memo, fee string, sequence int64) ([]byte, error) {
+	info, err := kr.Show(name)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := hbc.GetWithdrawAllRewardsMsgs(info.Address)
+	if err != nil {
+		return nil, err
+	}
+	return hbc.TxBuilder().CreateMsgsTransactionByName(kr, name, msgs, memo, fee, sequence)
+}
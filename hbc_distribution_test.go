@@ -0,0 +1,46 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func TestWithdrawAllRewards(t *testing.T) {
+	prikeyByte, _ := hex.DecodeString("da0bbe0acb8aae423de68a1a59379512d9d92ed453743592d6c3b2bc04252640")
+	delAddr := utils.CUAddressFromPubKey(SecpPrivKeyGen(prikeyByte).PubKey())
+	val1 := utils.ValAddress(utils.NewCUAddress())
+	val2 := utils.ValAddress(utils.NewCUAddress())
+
+	client, closer := newTestLCD(t, map[string]string{
+		"/distribution/delegators/" + delAddr.String() + "/rewards": fmt.Sprintf(`{"height":"10","result":{"rewards":[{"validator_address":"%s","reward":[{"denom":"hbc","amount":"1.500000000000000000"}]},{"validator_address":"%s","reward":[{"denom":"hbc","amount":"2.000000000000000000"}]}],"total":[{"denom":"hbc","amount":"3.500000000000000000"}]}}`, val1, val2),
+		"/distribution/community_pool":                              `{"height":"10","result":[{"denom":"hbc","amount":"10.000000000000000000"}]}`,
+	})
+	defer closer()
+
+	rewards, err := client.GetDelegatorRewards(delAddr.String())
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(35, 1), rewards.Total.AmountOf("hbc"))
+
+	pool, err := client.GetCommunityPool()
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDec(10), pool.AmountOf("hbc"))
+
+	txData, err := client.CreateWithdrawAllRewardsTransaction(prikeyByte, delAddr.String(), "", DefaultFee, 1)
+	require.Nil(t, err)
+
+	var sendData tx.SendData
+	require.Nil(t, tx.Cdc.UnmarshalJSON(txData, &sendData))
+	require.Equal(t, []utils.Msg{
+		utils.NewMsgWithdrawDelegatorReward(delAddr, val1),
+		utils.NewMsgWithdrawDelegatorReward(delAddr, val2),
+	}, sendData.Tx.Msgs)
+
+	require.Nil(t, utils.NewMsgWithdrawValidatorCommission(val1).ValidateBasic())
+	require.NotNil(t, utils.NewMsgSetWithdrawAddress(delAddr, nil).ValidateBasic())
+}
@@ -0,0 +1,163 @@
+package hbc
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+type MintParams struct {
+	MintDenom           string  `json:"mint_denom"`
+	InflationRateChange sdk.Dec `json:"inflation_rate_change"`
+	InflationMax        sdk.Dec `json:"inflation_max"`
+	InflationMin        sdk.Dec `json:"inflation_min"`
+	GoalBonded          sdk.Dec `json:"goal_bonded"`
+	BlocksPerYear       uint64  `json:"blocks_per_year,string"`
+}
+
+type DistributionParams struct {
+	CommunityTax        sdk.Dec `json:"community_tax"`
+	BaseProposerReward  sdk.Dec `json:"base_proposer_reward"`
+	BonusProposerReward sdk.Dec `json:"bonus_proposer_reward"`
+	WithdrawAddrEnabled bool    `json:"withdraw_addr_enabled"`
+}
+
+type DepositParams struct {
+	MinDeposit       utils.Coins   `json:"min_deposit"`
+	MaxDepositPeriod time.Duration `json:"max_deposit_period,string"`
+}
+
+type VotingParams struct {
+	VotingPeriod time.Duration `json:"voting_period,string"`
+}
+
+type TallyParams struct {
+	Quorum    sdk.Dec `json:"quorum"`
+	Threshold sdk.Dec `json:"threshold"`
+	Veto      sdk.Dec `json:"veto"`
+}
+
+type GovParams struct {
+	DepositParams DepositParams
+	VotingParams  VotingParams
+	TallyParams   TallyParams
+}
+
+func (hbc *Hbc) GetTotalSupply() (utils.Coins, error) {
+	var supply utils.Coins
+	err := hbc.queryResult("/supply/total", map[string]interface {
+	}{}, &supply)
+	if err != nil {
+		return nil, err
+	}
+	return supply.Sort(), nil
+}
+
+func (hbc *Hbc) GetSupplyOf(denom string) (sdk.Int, error) {
+	var supply sdk.Int
+	err := hbc.queryResult("/supply/total/"+denom, map[string]interface {
+	}{}, &supply)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return supply, nil
+}
+
+func (hbc *Hbc) GetMintParams() (*MintParams, error) {
+	var params MintParams
+	err := hbc.queryResult("/minting/parameters", map[string]interface {
+	}{}, &params)
+	if err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+func (hbc *Hbc) GetInflation() (sdk.Dec, error) {
+	var inflation sdk.Dec
+	err := hbc.queryResult("/minting/inflation", map[string]interface {
+	}{}, &inflation)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return inflation, nil
+}
+
+func (hbc *Hbc) GetAnnualProvisions() (sdk.Dec, error) {
+	var provisions sdk.Dec
+	err := hbc.queryResult("/minting/annual-provisions", map[string]interface {
+	}{}, &provisions)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return provisions, nil
+}
+
+func (hbc *Hbc) GetDistributionParams() (*DistributionParams, error) {
+	var params DistributionParams
+	err := hbc.queryResult("/distribution/parameters", map[string]interface {
+	}{}, &params)
+	if err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+func (hbc *Hbc) GetGovParams() (*GovParams, error) {
+	var params GovParams
+	err := hbc.queryResult("/gov/parameters/deposit", map[string]interface {
+	}{}, &params.DepositParams)
+	if err != nil {
+		return nil, err
+	}
+	err = hbc.queryResult("/gov/parameters/voting", map[string]interface {
+	}{}, &params.VotingParams)
+	if err != nil {
+		return nil, err
+	}
+	err = hbc.queryResult("/gov/parameters/tallying", map[string]interface {
+	}{}, &params.TallyParams)
+	if err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+// GetMinGasPrices returns the minimum gas prices the node accepts transactions with.
+func (hbc *Hbc) GetMinGasPrices() (sdk.DecCoins, error) {
+	var prices sdk.DecCoins
+	err := hbc.queryResult("/minimum_gas_prices", map[string]interface {
+	}{}, &prices)
+	if err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+// StakingAPR returns the yearly reward rate of bonded tokens before validator commission,
+// annualProvisions * (1 - communityTax) / bondedTokens.
+func StakingAPR(annualProvisions sdk.Dec, bondedTokens sdk.Int, communityTax sdk.Dec) (sdk.Dec, error) {
+	if !bondedTokens.IsPositive() {
+		return sdk.Dec{}, errors.New("no bonded tokens")
+	}
+	return annualProvisions.Mul(sdk.OneDec().Sub(communityTax)).QuoInt(bondedTokens), nil
+}
+
+// GetStakingAPR computes StakingAPR from the current chain state.
+func (hbc *Hbc) GetStakingAPR() (sdk.Dec, error) {
+	provisions, err := hbc.GetAnnualProvisions()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	pool, err := hbc.GetStakingPool()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	params, err := hbc.GetDistributionParams()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return StakingAPR(provisions, pool.BondedTokens, params.CommunityTax)
+}
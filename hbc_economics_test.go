@@ -0,0 +1,132 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func TestEconomicsQueries(t *testing.T) {
+	client, closer := newTestLCD(t, map[string]string{
+		"/supply/total":              `{"height":"1","result":[{"denom":"hbc","amount":"1000000"},{"denom":"btc","amount":"21"}]}`,
+		"/supply/total/hbc":          `{"height":"1","result":"1000000"}`,
+		"/minting/parameters":        `{"height":"1","result":{"mint_denom":"hbc","inflation_rate_change":"0.130000000000000000","inflation_max":"0.200000000000000000","inflation_min":"0.070000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}}`,
+		"/minting/inflation":         `{"height":"1","result":"0.100000000000000000"}`,
+		"/minting/annual-provisions": `{"height":"1","result":"100000.000000000000000000"}`,
+		"/staking/pool":              `{"height":"1","result":{"not_bonded_tokens":"100","bonded_tokens":"500000"}}`,
+		"/distribution/parameters":   `{"height":"1","result":{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true}}`,
+		"/gov/parameters/deposit":    `{"height":"1","result":{"min_deposit":[{"denom":"hbc","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
+		"/gov/parameters/voting":     `{"height":"1","result":{"voting_period":"172800000000000"}}`,
+		"/gov/parameters/tallying":   `{"height":"1","result":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto":"0.334000000000000000"}}`,
+		"/node_info":                 `{"node_info":{"network":"hbtc-mainnet"},"application_version":{}}`,
+		"/api/v1/default_fee":        `{"fee":"2000000000000","gas":"300000"}`,
+		"/minimum_gas_prices":        `{"height":"1","result":[{"denom":"hbc","amount":"0.000001000000000000"}]}`,
+	})
+	defer closer()
+
+	supply, err := client.GetTotalSupply()
+	require.Nil(t, err)
+	require.Equal(t, "btc", supply[0].Denom)
+	supplyOf, err := client.GetSupplyOf("hbc")
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(1000000), supplyOf)
+
+	mintParams, err := client.GetMintParams()
+	require.Nil(t, err)
+	require.Equal(t, uint64(6311520), mintParams.BlocksPerYear)
+	inflation, err := client.GetInflation()
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), inflation)
+
+	govParams, err := client.GetGovParams()
+	require.Nil(t, err)
+	require.Equal(t, 48*time.Hour, govParams.VotingParams.VotingPeriod)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), govParams.TallyParams.Threshold)
+	require.Equal(t, int64(10000000), govParams.DepositParams.MinDeposit.AmountOf("hbc").Int64())
+
+	apr, err := client.GetStakingAPR()
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(196, 3), apr)
+
+	prices, err := client.GetMinGasPrices()
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(1, 6), prices.AmountOf("hbc"))
+
+	params, err := client.GetTxParams()
+	require.Nil(t, err)
+	require.Equal(t, "hbtc-mainnet", params.ChainID)
+	require.Equal(t, DefaultFee, params.MinFee.String())
+
+	client.Network.FeeOracle = client.RestUrl + "/api/v1/default_fee"
+	params, err = client.GetTxParams()
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(2000000000000), params.MinFee)
+	require.Equal(t, uint64(DefaultGasLimit), params.GasLimit)
+
+	priKey, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	address, _, err := CreateAddress(priKey)
+	require.Nil(t, err)
+	from, err := utils.CUAddressFromBase58(address)
+	require.Nil(t, err)
+	msg := utils.NewMsgSend(from, from, utils.NewCoins(utils.NewInt64Coin("hbc", 1)))
+
+	txData, err := CreateMsgsTransactionWithParams(*params, priKey, []utils.Msg{msg}, "", "0", 1)
+	require.Nil(t, err)
+	var sendData tx.SendData
+	require.Nil(t, tx.Cdc.UnmarshalJSON(txData, &sendData))
+	require.Equal(t, params.MinFee, sendData.Tx.Fee.Amount.AmountOf("hbc"))
+
+	b, err := client.ChainTxBuilder()
+	require.Nil(t, err)
+	txData, err = b.CreateTransaction("hbc", priKey, address, address, "", "1", "0", 1)
+	require.Nil(t, err)
+	require.Nil(t, tx.Cdc.UnmarshalJSON(txData, &sendData))
+	require.Equal(t, params.MinFee, sendData.Tx.Fee.Amount.AmountOf("hbc"))
+	sig := sendData.Tx.Signatures[0]
+	require.True(t, sig.PubKey.VerifyBytes(tx.StdSignBytes("hbtc-mainnet", 1, sendData.Tx.Msgs, "", sendData.Tx.Fee), sig.Signature))
+}
+
+func TestTxParamsFloor(t *testing.T) {
+	client, closer := newTestLCD(t, map[string]string{
+		"/node_info":          `{"node_info":{"network":"hbtc-testnet"},"application_version":{}}`,
+		"/api/v1/default_fee": `{"fee":"0","gas":""}`,
+		"/minimum_gas_prices": `{"height":"1","result":[{"denom":"btc","amount":"1000000000.000000000000000000"}]}`,
+	})
+	defer closer()
+	client.Network.FeeOracle = client.RestUrl + "/api/v1/default_fee"
+
+	params, err := client.GetTxParams()
+	require.Nil(t, err)
+	require.Equal(t, DefaultFee, params.MinFee.String())
+	require.Equal(t, uint64(DefaultGasLimit), params.GasLimit)
+
+	// the node minimum gas price of the fee denom raises the fee for the gas limit
+	pricedClient, closePriced := newTestLCD(t, map[string]string{
+		"/node_info":          `{"node_info":{"network":"hbtc-testnet"},"application_version":{}}`,
+		"/minimum_gas_prices": `{"height":"1","result":[{"denom":"hbc","amount":"1500000.000000000000000000"}]}`,
+	})
+	defer closePriced()
+	params, err = pricedClient.GetTxParams()
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(1500000*int64(DefaultGasLimit)), params.MinFee)
+
+	b := DefaultTxBuilder()
+	b.Params = &TxParams{MinFee: sdk.NewInt(1)}
+	chainParams, err := b.txParams()
+	require.Nil(t, err)
+	require.Equal(t, DefaultFee, chainParams.MinFee.String())
+	require.Equal(t, DefaultChainID, chainParams.ChainID)
+	require.Equal(t, DefaultTokenId, chainParams.FeeDenom)
+
+	// params without fee take the one of the network
+	b.Params = &TxParams{GasLimit: 300000}
+	chainParams, err = b.txParams()
+	require.Nil(t, err)
+	require.Equal(t, DefaultFee, chainParams.MinFee.String())
+	require.Equal(t, uint64(300000), chainParams.GasLimit)
+}
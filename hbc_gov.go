@@ -0,0 +1,128 @@
+package hbc
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+// status filters of the proposals query
+const (
+	ProposalStatusDepositPeriod = "deposit_period"
+	ProposalStatusVotingPeriod  = "voting_period"
+	ProposalStatusPassed        = "passed"
+	ProposalStatusRejected      = "rejected"
+)
+
+type ProposalContent struct {
+	Type  string `json:"type"`
+	Value struct {
+		Title       string              `json:"title"`
+		Description string              `json:"description"`
+		Changes     []utils.ParamChange `json:"changes,omitempty"`
+	} `json:"value"`
+}
+
+type TallyResult struct {
+	Yes        sdk.Int `json:"yes"`
+	Abstain    sdk.Int `json:"abstain"`
+	No         sdk.Int `json:"no"`
+	NoWithVeto sdk.Int `json:"no_with_veto"`
+}
+
+type Proposal struct {
+	Content          ProposalContent `json:"content"`
+	ProposalID       uint64          `json:"id,string"`
+	Status           string          `json:"proposal_status"`
+	FinalTallyResult TallyResult     `json:"final_tally_result"`
+	SubmitTime       time.Time       `json:"submit_time"`
+	DepositEndTime   time.Time       `json:"deposit_end_time"`
+	TotalDeposit     utils.Coins     `json:"total_deposit"`
+	VotingStartTime  time.Time       `json:"voting_start_time"`
+	VotingEndTime    time.Time       `json:"voting_end_time"`
+}
+
+type Deposit struct {
+	ProposalID uint64          `json:"proposal_id,string"`
+	Depositor  utils.CUAddress `json:"depositor"`
+	Amount     utils.Coins     `json:"amount"`
+}
+
+type Vote struct {
+	ProposalID uint64           `json:"proposal_id,string"`
+	Voter      utils.CUAddress  `json:"voter"`
+	Option     utils.VoteOption `json:"option"`
+}
+
+// ProposalFilter filters the proposals query, empty fields are ignored.
+type ProposalFilter struct {
+	Voter     string
+	Depositor string
+	Status    string
+	Limit     int
+}
+
+func (hbc *Hbc) GetProposals(filter ProposalFilter) ([]Proposal, error) {
+	args := map[string]interface{}{}
+	if filter.Voter != "" {
+		args["voter"] = filter.Voter
+	}
+	if filter.Depositor != "" {
+		args["depositor"] = filter.Depositor
+	}
+	if filter.Status != "" {
+		args["status"] = filter.Status
+	}
+	if filter.Limit > 0 {
+		args["limit"] = strconv.Itoa(filter.Limit)
+	}
+
+	var proposals []Proposal
+	err := hbc.queryResult("/gov/proposals", args, &proposals)
+	if err != nil {
+		return nil, err
+	}
+	return proposals, nil
+}
+
+func (hbc *Hbc) GetProposal(proposalID uint64) (*Proposal, error) {
+	var proposal Proposal
+	err := hbc.queryResult("/gov/proposals/"+strconv.FormatUint(proposalID, 10), map[string]interface {
+	}{}, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+func (hbc *Hbc) GetProposalTally(proposalID uint64) (*TallyResult, error) {
+	var tally TallyResult
+	err := hbc.queryResult("/gov/proposals/"+strconv.FormatUint(proposalID, 10)+"/tally", map[string]interface {
+	}{}, &tally)
+	if err != nil {
+		return nil, err
+	}
+	return &tally, nil
+}
+
+func (hbc *Hbc) GetProposalDeposits(proposalID uint64) ([]Deposit, error) {
+	var deposits []Deposit
+	err := hbc.queryResult("/gov/proposals/"+strconv.FormatUint(proposalID, 10)+"/deposits", map[string]interface {
+	}{}, &deposits)
+	if err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
+
+func (hbc *Hbc) GetProposalVotes(proposalID uint64) ([]Vote, error) {
+	var votes []Vote
+	err := hbc.queryResult("/gov/proposals/"+strconv.FormatUint(proposalID, 10)+"/votes", map[string]interface {
+	}{}, &votes)
+	if err != nil {
+		return nil, err
+	}
+	return votes, nil
+}
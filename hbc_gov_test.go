@@ -0,0 +1,76 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func TestGovQueries(t *testing.T) {
+	voter := utils.NewCUAddress()
+	client, closer := newTestLCD(t, map[string]string{
+		"/gov/proposals":         `{"height":"10","result":[{"content":{"type":"hbtcchain/gov/TextProposal","value":{"title":"upgrade","description":"upgrade the chain"}},"id":"3","proposal_status":"VotingPeriod","final_tally_result":{"yes":"0","abstain":"0","no":"0","no_with_veto":"0"},"submit_time":"2021-03-01T00:00:00Z","deposit_end_time":"2021-03-03T00:00:00Z","total_deposit":[{"denom":"hbc","amount":"1000"}],"voting_start_time":"2021-03-02T00:00:00Z","voting_end_time":"2021-03-04T00:00:00Z"}]}`,
+		"/gov/proposals/3/tally": `{"height":"10","result":{"yes":"100","abstain":"0","no":"20","no_with_veto":"0"}}`,
+		"/gov/proposals/3/votes": fmt.Sprintf(`{"height":"10","result":[{"proposal_id":"3","voter":"%s","option":"NoWithVeto"}]}`, voter),
+	})
+	defer closer()
+
+	proposals, err := client.GetProposals(ProposalFilter{Status: ProposalStatusVotingPeriod, Limit: 10})
+	require.Nil(t, err)
+	require.Len(t, proposals, 1)
+	require.Equal(t, uint64(3), proposals[0].ProposalID)
+	require.Equal(t, "upgrade", proposals[0].Content.Value.Title)
+
+	tally, err := client.GetProposalTally(3)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(100), tally.Yes)
+
+	votes, err := client.GetProposalVotes(3)
+	require.Nil(t, err)
+	require.Equal(t, voter, votes[0].Voter)
+	require.Equal(t, utils.OptionNoWithVeto, votes[0].Option)
+}
+
+func TestGovMsgs(t *testing.T) {
+	prikeyByte, _ := hex.DecodeString("da0bbe0acb8aae423de68a1a59379512d9d92ed453743592d6c3b2bc04252640")
+	addr := utils.CUAddressFromPubKey(SecpPrivKeyGen(prikeyByte).PubKey())
+	deposit := utils.NewCoins(utils.NewInt64Coin("hbc", 100))
+
+	option, err := utils.VoteOptionFromString("yes")
+	require.Nil(t, err)
+	_, err = utils.VoteOptionFromString("maybe")
+	require.NotNil(t, err)
+
+	msgs := []utils.Msg{
+		utils.NewMsgSubmitProposal(utils.NewTextProposal("upgrade", "upgrade the chain"), deposit, addr),
+		utils.NewMsgSubmitProposal(utils.NewParameterChangeProposal("gas", "raise max gas", []utils.ParamChange{
+			utils.NewParamChange("baseapp", "BlockParams", `{"max_gas":"4000000"}`),
+		}), deposit, addr),
+		utils.NewMsgDeposit(addr, 3, deposit),
+		utils.NewMsgVote(addr, 3, option),
+	}
+
+	txData, err := CreateMsgsTransaction(prikeyByte, msgs, "", DefaultFee, 1)
+	require.Nil(t, err)
+	require.Contains(t, string(txData), `"option":"Yes"`)
+
+	var sendData tx.SendData
+	require.Nil(t, tx.Cdc.UnmarshalJSON(txData, &sendData))
+	require.Equal(t, msgs, sendData.Tx.Msgs)
+
+	_, err = CreateMsgsTransaction(prikeyByte, []utils.Msg{utils.NewMsgVote(addr, 3, utils.VoteOption(7))}, "", DefaultFee, 1)
+	require.NotNil(t, err)
+	_, err = CreateMsgsTransaction(prikeyByte, []utils.Msg{utils.NewMsgSubmitProposal(utils.NewTextProposal("", "no title"), deposit, addr)}, "", DefaultFee, 1)
+	require.NotNil(t, err)
+
+	// deposits without amount are rejected, not dereferenced
+	for _, missing := range []utils.Coins{{utils.Coin{Denom: "hbc"}}, {utils.NewInt64Coin("btc", 1), utils.Coin{Denom: "hbc"}}} {
+		require.NotNil(t, utils.NewMsgSubmitProposal(utils.NewTextProposal("upgrade", "upgrade the chain"), missing, addr).ValidateBasic())
+		require.NotNil(t, utils.NewMsgDeposit(addr, 3, missing).ValidateBasic())
+	}
+}
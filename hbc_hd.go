@@ -0,0 +1,187 @@
+package hbc
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	"github.com/cosmos/go-bip39"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+const (
+	// MnemonicEntropySize is the entropy size in bits of a 24 word mnemonic
+	MnemonicEntropySize = 256
+)
+
+// NewMnemonic generates a new BIP39 mnemonic.
+// bitSize must be a multiple of 32 between 128 and 256, 0 means MnemonicEntropySize.
+func NewMnemonic(bitSize int) (string, error) {
+	if bitSize == 0 {
+		bitSize = MnemonicEntropySize
+	}
+
+	entropy, err := bip39.NewEntropy(bitSize)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// IsMnemonicValid checks the words and the checksum of a BIP39 mnemonic.
+func IsMnemonicValid(mnemonic string) bool {
+	return bip39.IsMnemonicValid(normalizeMnemonic(mnemonic))
+}
+
+// NewSeedFromMnemonic returns the BIP39 seed of a mnemonic protected by passphrase.
+func NewSeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	mnemonic = normalizeMnemonic(mnemonic)
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, errors.New("invalid mnemonic")
+	}
+
+	return bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+}
+
+// HDPath returns the BIP44 path of the HBC coin type, i.e. 44'/496'/account'/0/index.
+func HDPath(account, index uint32) string {
+	return hd.NewFundraiserParams(account, utils.CoinType, index).String()
+}
+
+// DerivePrivKeyFromSeed derives the private key at the BIP32 path from a BIP39 seed.
+func DerivePrivKeyFromSeed(seed []byte, path string) ([]byte, error) {
+	if len(seed) == 0 {
+		return nil, errors.New("empty seed")
+	}
+
+	master, chainCode := hd.ComputeMastersFromSeed(seed)
+	derived, err := hd.DerivePrivateKeyForPath(master, chainCode, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return derived[:], nil
+}
+
+// DerivePrivKeyFromMnemonic derives the private key at the BIP32 path from a BIP39 mnemonic.
+func DerivePrivKeyFromMnemonic(mnemonic, passphrase, path string) ([]byte, error) {
+	seed, err := NewSeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return DerivePrivKeyFromSeed(seed, path)
+}
+
+// CreateAddressFromMnemonic returns the address and the private key at 44'/496'/account'/0/index.
+func CreateAddressFromMnemonic(mnemonic, passphrase string, account, index uint32) (string, []byte, error) {
+	priv, err := DerivePrivKeyFromMnemonic(mnemonic, passphrase, HDPath(account, index))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return CreateAddress(priv)
+}
+
+// CreateAddressFromSeed returns the address and the private key at 44'/496'/account'/0/index.
+func CreateAddressFromSeed(seed []byte, account, index uint32) (string, []byte, error) {
+	priv, err := DerivePrivKeyFromSeed(seed, HDPath(account, index))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return CreateAddress(priv)
+}
+
+func normalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(mnemonic), " ")
+}
+
+// NewAccountExtendedKey returns the extended private key of m/44'/496'/account'.
+func NewAccountExtendedKey(seed []byte, account uint32) (*hdkeychain.ExtendedKey, error) {
+	key, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, idx := range []uint32{44, utils.CoinType, account} {
+		key, err = key.Child(hdkeychain.HardenedKeyStart + idx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// AccountXPub returns the extended public key (xpub) of m/44'/496'/account'.
+// Addresses of the account can be derived from it without the private keys.
+func AccountXPub(seed []byte, account uint32) (string, error) {
+	key, err := NewAccountExtendedKey(seed, account)
+	if err != nil {
+		return "", err
+	}
+
+	pub, err := key.Neuter()
+	if err != nil {
+		return "", err
+	}
+
+	return pub.String(), nil
+}
+
+// AccountXPubFromMnemonic returns the extended public key (xpub) of m/44'/496'/account'.
+func AccountXPubFromMnemonic(mnemonic, passphrase string, account uint32) (string, error) {
+	seed, err := NewSeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	return AccountXPub(seed, account)
+}
+
+// DerivePubKeyFromXPub derives the public key at change/index of an account xpub.
+// Only non-hardened derivation is possible from a public key.
+func DerivePubKeyFromXPub(xpub string, change, index uint32) (crypto.PubKey, error) {
+	key, err := hdkeychain.NewKeyFromString(xpub)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsPrivate() {
+		return nil, errors.New("extended key is private, expect xpub")
+	}
+	if change >= hdkeychain.HardenedKeyStart || index >= hdkeychain.HardenedKeyStart {
+		return nil, hdkeychain.ErrDeriveHardFromPublic
+	}
+
+	for _, idx := range []uint32{change, index} {
+		key, err = key.Child(idx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ecPub, err := key.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var pub secp256k1.PubKeySecp256k1
+	copy(pub[:], ecPub.SerializeCompressed())
+	return pub, nil
+}
+
+// CreateAddressFromXPub returns the address at 0/index of an account xpub.
+func CreateAddressFromXPub(xpub string, index uint32) (string, error) {
+	pub, err := DerivePubKeyFromXPub(xpub, 0, index)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.CUAddressFromPubKey(pub).String(), nil
+}
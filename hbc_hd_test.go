@@ -0,0 +1,118 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+const testMnemonic = "equip will roof matter pink blind book anxiety banner elbow sun young"
+
+func TestHDPath(t *testing.T) {
+	require.Equal(t, utils.FullFundraiserPath, HDPath(0, 0))
+	require.Equal(t, "44'/496'/1'/0/7", HDPath(1, 7))
+}
+
+func TestMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic(0)
+	require.Nil(t, err)
+	require.True(t, IsMnemonicValid(mnemonic))
+	require.True(t, IsMnemonicValid(testMnemonic))
+	require.False(t, IsMnemonicValid("equip will roof matter pink blind book anxiety banner elbow sun"))
+	require.False(t, IsMnemonicValid("equip will roof matter pink blind book anxiety banner elbow sun hbtc"))
+
+	_, err = NewMnemonic(100)
+	require.NotNil(t, err)
+}
+
+// TestHDVectors checks the derivation against the published BIP39 and BIP44 vectors of the
+// all zero entropy mnemonic.
+func TestHDVectors(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	// BIP39 reference vector
+	seed, err := NewSeedFromMnemonic(mnemonic, "TREZOR")
+	require.Nil(t, err)
+	require.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04", hex.EncodeToString(seed))
+
+	// first bitcoin account address, 44'/0'/0'/0/0
+	seed, err = NewSeedFromMnemonic(mnemonic, "")
+	require.Nil(t, err)
+	priv, err := DerivePrivKeyFromSeed(seed, "44'/0'/0'/0/0")
+	require.Nil(t, err)
+	pub := SecpPrivKeyGen(priv).PubKey().(secp256k1.PubKeySecp256k1)
+	btcAddr, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(pub[:]), &chaincfg.MainNetParams)
+	require.Nil(t, err)
+	require.Equal(t, "1LqBGSKuX5yYUonjxT5qGfpUsXKYYWeabA", btcAddr.EncodeAddress())
+
+	// first HBC address, 44'/496'/0'/0/0, the pubkey matching the derivation of hdkeychain
+	address, priv, err := CreateAddressFromMnemonic(mnemonic, "", 0, 0)
+	require.Nil(t, err)
+	pub = SecpPrivKeyGen(priv).PubKey().(secp256k1.PubKeySecp256k1)
+	require.Equal(t, "026c8544d122288875beb8fc09e4ac733d33cbf05e432f831bb2cfcdc099c9d424", hex.EncodeToString(pub[:]))
+	require.Equal(t, "HBCTKpnewt3iDg2ABmEh7ULST2DNhs5oBH8R", address)
+
+	xpub, err := AccountXPubFromMnemonic(mnemonic, "", 0)
+	require.Nil(t, err)
+	xpubAddress, err := CreateAddressFromXPub(xpub, 0)
+	require.Nil(t, err)
+	require.Equal(t, address, xpubAddress)
+}
+
+func TestCreateAddressFromMnemonic(t *testing.T) {
+	addr0, priv0, err := CreateAddressFromMnemonic(testMnemonic, "", 0, 0)
+	require.Nil(t, err)
+	require.True(t, utils.IsValidAddr(addr0))
+
+	addr, _, err := CreateAddress(priv0)
+	require.Nil(t, err)
+	require.Equal(t, addr0, addr)
+
+	addr1, _, err := CreateAddressFromMnemonic(testMnemonic, "", 0, 1)
+	require.Nil(t, err)
+	require.NotEqual(t, addr0, addr1)
+
+	addrPass, _, err := CreateAddressFromMnemonic(testMnemonic, "passphrase", 0, 0)
+	require.Nil(t, err)
+	require.NotEqual(t, addr0, addrPass)
+
+	seed, err := NewSeedFromMnemonic(testMnemonic, "")
+	require.Nil(t, err)
+	addrSeed, _, err := CreateAddressFromSeed(seed, 0, 1)
+	require.Nil(t, err)
+	require.Equal(t, addr1, addrSeed)
+
+	_, _, err = CreateAddressFromMnemonic("not a mnemonic", "", 0, 0)
+	require.NotNil(t, err)
+}
+
+func TestCreateAddressFromXPub(t *testing.T) {
+	xpub, err := AccountXPubFromMnemonic(testMnemonic, "", 0)
+	require.Nil(t, err)
+	require.Equal(t, "xpub", xpub[:4])
+
+	for _, index := range []uint32{0, 1, 25} {
+		expected, _, err := CreateAddressFromMnemonic(testMnemonic, "", 0, index)
+		require.Nil(t, err)
+
+		addr, err := CreateAddressFromXPub(xpub, index)
+		require.Nil(t, err)
+		require.Equal(t, expected, addr)
+	}
+
+	_, err = CreateAddressFromXPub(xpub, hdkeychain.HardenedKeyStart)
+	require.NotNil(t, err)
+
+	seed, err := NewSeedFromMnemonic(testMnemonic, "")
+	require.Nil(t, err)
+	xprv, err := NewAccountExtendedKey(seed, 0)
+	require.Nil(t, err)
+	_, err = CreateAddressFromXPub(xprv.String(), 0)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,115 @@
+package hbc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+// CreateKeyGenTransaction signs a transaction requesting the deposit address of toAddress on the
+// chain of symbol. It returns the transaction and the order id of the request.
+func CreateKeyGenTransaction(fromPriKey []byte, symbol, toAddress, memo, fee string, sequence int64) ([]byte, string, error) {
+	return DefaultTxBuilder().CreateKeyGenTransaction(fromPriKey, symbol, toAddress, memo, fee, sequence)
+}
+
+func (b *TxBuilder) CreateKeyGenTransaction(fromPriKey []byte, symbol, toAddress, memo, fee string, sequence int64) ([]byte, string, error) {
+	fromAddress, _, err := CreateAddress(fromPriKey)
+	if err != nil {
+		return nil, "", err
+	}
+	msg, err := newKeyGenMsg(symbol, fromAddress, toAddress)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txData, err := b.CreateMsgsTransaction(fromPriKey, []utils.Msg{msg}, memo, fee, sequence)
+	if err != nil {
+		return nil, "", err
+	}
+	return txData, msg.OrderID, nil
+}
+
+// CreateKeyGenTransactionByName is CreateKeyGenTransaction signing with the key name of the keyring.
+func CreateKeyGenTransactionByName(kr Keyring, name, symbol, toAddress, memo, fee string, sequence int64) ([]byte, string, error) {
+	return DefaultTxBuilder().CreateKeyGenTransactionByName(kr, name, symbol, toAddress, memo, fee, sequence)
+}
+
+func (b *TxBuilder) CreateKeyGenTransactionByName(kr Keyring, name, symbol, toAddress, memo, fee string, sequence int64) ([]byte, string, error) {
+	info, err := kr.Show(name)
+	if err != nil {
+		return nil, "", err
+	}
+	msg, err := newKeyGenMsg(symbol, info.Address, toAddress)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txData, err := b.CreateMsgsTransactionByName(kr, name, []utils.Msg{msg}, memo, fee, sequence)
+	if err != nil {
+		return nil, "", err
+	}
+	return txData, msg.OrderID, nil
+}
+
+func newKeyGenMsg(symbol, fromAddress, toAddress string) (utils.MsgKeyGen, error) {
+	from, err := utils.CUAddressFromBase58(fromAddress)
+	if err != nil {
+		return utils.MsgKeyGen{}, err
+	}
+	to, err := utils.CUAddressFromBase58(toAddress)
+	if err != nil {
+		return utils.MsgKeyGen{}, err
+	}
+	return utils.NewMsgKeyGen(utils.NewOrderID(), symbol, from, to), nil
+}
+
+// GetDepositAddress returns the deposit address of address for symbol on chain, "" while the
+// keygen has not completed, the CU of address included.
+func (hbc *Hbc) GetDepositAddress(address, chain, symbol string) (string, error) {
+	cu, err := hbc.GetCU(address)
+	if errors.Is(err, ErrCUNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	depositAddr, _ := cu.DepositAddress(chain, symbol)
+	return depositAddr, nil
+}
+
+// WaitForDepositAddress polls every interval until the deposit address of address for symbol
+// on chain appears or ctx is done.
+func (hbc *Hbc) WaitForDepositAddress(ctx context.Context, address, chain, symbol string, interval time.Duration) (string, error) {
+	if err := validatePollInterval(interval); err != nil {
+		return "", err
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		depositAddr, err := hbc.GetDepositAddress(address, chain, symbol)
+		if err != nil {
+			return "", err
+		}
+		if depositAddr != "" {
+			return depositAddr, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("no %v deposit address for %v: %w", symbol, address, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func validatePollInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid poll interval %v, must be positive", interval)
+	}
+	return nil
+}
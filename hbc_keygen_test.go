@@ -0,0 +1,97 @@
+package hbc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func TestCreateKeyGenTransaction(t *testing.T) {
+	priKey, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	address, _, err := CreateAddress(priKey)
+	require.Nil(t, err)
+
+	txData, orderID, err := CreateKeyGenTransaction(priKey, "eth", address, "", DefaultFee, 1)
+	require.Nil(t, err)
+	require.Len(t, orderID, 36)
+
+	var sendData tx.SendData
+	require.Nil(t, tx.Cdc.UnmarshalJSON(txData, &sendData))
+	msg := sendData.Tx.Msgs[0].(utils.MsgKeyGen)
+	require.Equal(t, orderID, msg.OrderID)
+	require.Equal(t, "eth", msg.Symbol)
+	require.Equal(t, address, msg.To.String())
+
+	_, _, err = CreateKeyGenTransaction(priKey, "e", address, "", DefaultFee, 1)
+	require.NotNil(t, err)
+}
+
+func TestWaitForDepositAddress(t *testing.T) {
+	address := "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU"
+	var mtx sync.Mutex
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		requests++
+
+		// the CU does not exist until the keygen is executed
+		if requests == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"cu not found"}`))
+			return
+		}
+		assets := `[]`
+		if requests > 3 {
+			assets = `[{"chain":"eth","symbol":"eth","address":"0x81b7e08f65bdf5648606c89998a9cc8164397647","nonce":"0","enable_sendtx":true}]`
+		}
+		fmt.Fprintf(w, `{"height":"1","result":{"type":"hbtcchain/CustodianUnit","value":{"cu_type":1,"address":"%s","public_key":null,"sequence":"0","assets":%s}}}`, address, assets)
+	}))
+	defer server.Close()
+	client, err := NewHbcClient(server.URL)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	depositAddr, err := client.WaitForDepositAddress(ctx, address, "eth", "eth", time.Millisecond)
+	require.Nil(t, err)
+	require.Equal(t, "0x81b7e08f65bdf5648606c89998a9cc8164397647", depositAddr)
+	mtx.Lock()
+	require.Equal(t, 4, requests)
+	mtx.Unlock()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForDepositAddress(ctx, address, "btc", "btc", time.Millisecond)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = client.WaitForDepositAddress(ctx, address, "btc", "btc", time.Hour)
+	require.True(t, errors.Is(err, context.Canceled))
+
+	_, err = client.WaitForDepositAddress(context.Background(), address, "btc", "btc", 0)
+	require.NotNil(t, err)
+}
+
+func TestGetCUNotFound(t *testing.T) {
+	client, closeLCD := newTestLCD(t, map[string]string{})
+	defer closeLCD()
+
+	_, err := client.GetCU("HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU")
+	require.True(t, errors.Is(err, ErrCUNotFound))
+
+	depositAddr, err := client.GetDepositAddress("HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU", "eth", "eth")
+	require.Nil(t, err)
+	require.Equal(t, "", depositAddr)
+}
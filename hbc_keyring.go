@@ -0,0 +1,588 @@
+package hbc
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+type KeyType string
+
+const (
+	KeyTypeLocal     KeyType = "local"
+	KeyTypeHD        KeyType = "hd"
+	KeyTypeMultisig  KeyType = "multisig"
+	KeyTypeWatchOnly KeyType = "watch-only"
+
+	// TestKeyringPassword encrypts the keys of the test keyring
+	TestKeyringPassword = "test"
+
+	keyringFileExt = ".json"
+)
+
+var (
+	ErrKeyNotFound = errors.New("key not found")
+	ErrKeyExists   = errors.New("key already exists")
+
+	reKeyName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,63}$`)
+
+	// low cost kdf for keys that never leave the process or only exist in tests
+	lightScryptParams = KDFParams{N: 1 << 12, R: 8, P: 1}
+)
+
+// KeyInfo is the public part of a keyring entry.
+type KeyInfo struct {
+	Name      string        `json:"name"`
+	Type      KeyType       `json:"type"`
+	Address   string        `json:"address"`
+	PubKey    crypto.PubKey `json:"-"`
+	HDPath    string        `json:"hd_path,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type keyInfoJSON struct {
+	Name      string    `json:"name"`
+	Type      KeyType   `json:"type"`
+	Address   string    `json:"address"`
+	PubKey    []byte    `json:"pubkey"`
+	HDPath    string    `json:"hd_path,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MarshalJSON encodes the pubkey with amino.
+func (info KeyInfo) MarshalJSON() ([]byte, error) {
+	var pubKey []byte
+	if info.PubKey != nil {
+		pubKey = info.PubKey.Bytes()
+	}
+	return json.Marshal(keyInfoJSON{
+		Name:      info.Name,
+		Type:      info.Type,
+		Address:   info.Address,
+		PubKey:    pubKey,
+		HDPath:    info.HDPath,
+		CreatedAt: info.CreatedAt,
+	})
+}
+
+// UnmarshalJSON decodes the amino encoded pubkey.
+func (info *KeyInfo) UnmarshalJSON(data []byte) error {
+	var ij keyInfoJSON
+	if err := json.Unmarshal(data, &ij); err != nil {
+		return err
+	}
+
+	pubKey, err := cryptoAmino.PubKeyFromBytes(ij.PubKey)
+	if err != nil {
+		return err
+	}
+
+	*info = KeyInfo{
+		Name:      ij.Name,
+		Type:      ij.Type,
+		Address:   ij.Address,
+		PubKey:    pubKey,
+		HDPath:    ij.HDPath,
+		CreatedAt: ij.CreatedAt,
+	}
+	return nil
+}
+
+// Keyring manages named keys.
+type Keyring interface {
+	// List returns all keys sorted by name.
+	List() ([]KeyInfo, error)
+	// Show returns the key with the given name.
+	Show(name string) (KeyInfo, error)
+	// ShowByAddress returns the key with the given address.
+	ShowByAddress(address string) (KeyInfo, error)
+
+	// AddPrivKey stores a raw secp256k1 private key.
+	AddPrivKey(name string, privKey []byte) (KeyInfo, error)
+	// AddMnemonic stores the key at 44'/496'/account'/0/index of a mnemonic.
+	AddMnemonic(name, mnemonic, passphrase string, account, index uint32) (KeyInfo, error)
+	// NewMnemonic generates a mnemonic and stores its first key.
+	NewMnemonic(name, passphrase string) (KeyInfo, string, error)
+	// AddMultisig stores a threshold multisig pubkey.
+	AddMultisig(name string, threshold uint, pubkeys []crypto.PubKey) (KeyInfo, error)
+	// AddWatchOnly stores a pubkey without private key.
+	AddWatchOnly(name string, pubkey crypto.PubKey) (KeyInfo, error)
+
+	Delete(name string) error
+	Rename(oldName, newName string) error
+
+	// Sign signs msg with the private key of name.
+	Sign(name string, msg []byte) ([]byte, crypto.PubKey, error)
+	// ClearKeyCache zeroizes the keys derived from the password to decrypt the private keys,
+	// the next signatures derive them again.
+	ClearKeyCache()
+}
+
+type keyringEntry struct {
+	Info     KeyInfo   `json:"info"`
+	KeyStore *KeyStore `json:"keystore,omitempty"`
+}
+
+// keyringBackend stores keyring entries.
+type keyringBackend interface {
+	get(name string) (keyringEntry, error)
+	set(entry keyringEntry) error
+	delete(name string) error
+	list() ([]keyringEntry, error)
+}
+
+type keyring struct {
+	mtx       sync.Mutex
+	backend   keyringBackend
+	password  string
+	kdf       string
+	kdfParams KDFParams
+
+	// keys caches the derived keys by keystore salt, so the kdf runs once per key
+	keys map[string][]byte
+}
+
+var _ Keyring = &keyring{}
+
+// NewMemoryKeyring returns a keyring living in memory only.
+func NewMemoryKeyring() Keyring {
+	password := make([]byte, 32)
+	if _, err := rand.Read(password); err != nil {
+		panic(err)
+	}
+
+	return &keyring{
+		backend:   &memoryBackend{entries: map[string]keyringEntry{}},
+		password:  hex.EncodeToString(password),
+		kdf:       KDFScrypt,
+		kdfParams: lightScryptParams,
+	}
+}
+
+// NewDirKeyring returns a keyring storing one keystore file per key in dir,
+// encrypted with password and the default scrypt cost.
+func NewDirKeyring(dir, password string) (Keyring, error) {
+	return NewDirKeyringWithKDF(dir, password, KDFScrypt, KDFParams{})
+}
+
+// NewDirKeyringWithKDF is NewDirKeyring encrypting the new keys with kdf and params,
+// zero params taking the defaults of the kdf.
+//
+// The password is checked against the stored keys when the keyring is opened.
+// The key derived from the password is cached for each keystore, so the kdf cost
+// is paid once per key and not on every Sign, until ClearKeyCache.
+func NewDirKeyringWithKDF(dir, password, kdf string, params KDFParams) (Keyring, error) {
+	if password == "" {
+		return nil, errors.New("empty keyring password")
+	}
+	return openDirKeyring(dir, password, kdf, params)
+}
+
+// NewTestKeyring returns a directory keyring encrypted with TestKeyringPassword
+// and a low cost kdf. It must only be used in tests.
+func NewTestKeyring(dir string) (Keyring, error) {
+	return openDirKeyring(dir, TestKeyringPassword, KDFScrypt, lightScryptParams)
+}
+
+func openDirKeyring(dir, password, kdf string, params KDFParams) (Keyring, error) {
+	checked, err := params.withDefaults(kdf)
+	if err != nil {
+		return nil, err
+	}
+	if err := checked.Validate(kdf); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	kr := &keyring{
+		backend:   &dirBackend{dir: dir},
+		password:  password,
+		kdf:       kdf,
+		kdfParams: params,
+	}
+	if err := kr.checkPassword(); err != nil {
+		return nil, err
+	}
+	return kr, nil
+}
+
+// checkPassword unlocks the first stored private key with the keyring password.
+func (kr *keyring) checkPassword() error {
+	entries, err := kr.backend.list()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.KeyStore == nil {
+			continue
+		}
+		priv, err := kr.unlock(entry.KeyStore)
+		if err != nil {
+			return fmt.Errorf("invalid keyring password: %v", err)
+		}
+		ZeroPrivKey(priv)
+		return nil
+	}
+	return nil
+}
+
+// unlock decrypts the private key of ks with the cached derived key, deriving it
+// from the keyring password on the first use. The key must be released with ZeroPrivKey.
+func (kr *keyring) unlock(ks *KeyStore) (crypto.PrivKey, error) {
+	cacheKey := ks.Crypto.KDF + "/" + ks.Crypto.KDFParams.Salt
+
+	kr.mtx.Lock()
+	if key, ok := kr.keys[cacheKey]; ok {
+		defer kr.mtx.Unlock()
+		return ks.unlockWithKey(key)
+	}
+	kr.mtx.Unlock()
+
+	key, err := ks.deriveKey(kr.password)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := ks.unlockWithKey(key)
+	if err != nil {
+		zeroBytes(key)
+		return nil, err
+	}
+
+	kr.mtx.Lock()
+	if kr.keys == nil {
+		kr.keys = map[string][]byte{}
+	}
+	if _, ok := kr.keys[cacheKey]; ok {
+		zeroBytes(key)
+	} else {
+		kr.keys[cacheKey] = key
+	}
+	kr.mtx.Unlock()
+	return priv, nil
+}
+
+func (kr *keyring) ClearKeyCache() {
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	for cacheKey, key := range kr.keys {
+		zeroBytes(key)
+		delete(kr.keys, cacheKey)
+	}
+}
+
+func (kr *keyring) List() ([]KeyInfo, error) {
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	entries, err := kr.backend.list()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]KeyInfo, 0, len(entries))
+	for _, entry := range entries {
+		infos = append(infos, entry.Info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos, nil
+}
+
+func (kr *keyring) Show(name string) (KeyInfo, error) {
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	entry, err := kr.backend.get(name)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+	return entry.Info, nil
+}
+
+func (kr *keyring) ShowByAddress(address string) (KeyInfo, error) {
+	infos, err := kr.List()
+	if err != nil {
+		return KeyInfo{}, err
+	}
+
+	for _, info := range infos {
+		if info.Address == address {
+			return info, nil
+		}
+	}
+	return KeyInfo{}, ErrKeyNotFound
+}
+
+func (kr *keyring) AddPrivKey(name string, privKey []byte) (KeyInfo, error) {
+	return kr.addKey(name, KeyTypeLocal, privKey, "")
+}
+
+func (kr *keyring) AddMnemonic(name, mnemonic, passphrase string, account, index uint32) (KeyInfo, error) {
+	path := HDPath(account, index)
+	privKey, err := DerivePrivKeyFromMnemonic(mnemonic, passphrase, path)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+	defer zeroBytes(privKey)
+
+	return kr.addKey(name, KeyTypeHD, privKey, path)
+}
+
+func (kr *keyring) NewMnemonic(name, passphrase string) (KeyInfo, string, error) {
+	mnemonic, err := NewMnemonic(0)
+	if err != nil {
+		return KeyInfo{}, "", err
+	}
+
+	info, err := kr.AddMnemonic(name, mnemonic, passphrase, 0, 0)
+	if err != nil {
+		return KeyInfo{}, "", err
+	}
+	return info, mnemonic, nil
+}
+
+func (kr *keyring) AddMultisig(name string, threshold uint, pubkeys []crypto.PubKey) (KeyInfo, error) {
+	if threshold == 0 || int(threshold) > len(pubkeys) {
+		return KeyInfo{}, fmt.Errorf("invalid multisig threshold %d of %d keys", threshold, len(pubkeys))
+	}
+
+	mpk := multisig.NewPubKeyMultisigThreshold(int(threshold), pubkeys)
+	return kr.addPubKey(name, KeyTypeMultisig, mpk)
+}
+
+func (kr *keyring) AddWatchOnly(name string, pubkey crypto.PubKey) (KeyInfo, error) {
+	if pubkey == nil {
+		return KeyInfo{}, errors.New("missing pubkey")
+	}
+	return kr.addPubKey(name, KeyTypeWatchOnly, pubkey)
+}
+
+func (kr *keyring) Delete(name string) error {
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	if _, err := kr.backend.get(name); err != nil {
+		return err
+	}
+	return kr.backend.delete(name)
+}
+
+func (kr *keyring) Rename(oldName, newName string) error {
+	if err := validateKeyName(newName); err != nil {
+		return err
+	}
+
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	entry, err := kr.backend.get(oldName)
+	if err != nil {
+		return err
+	}
+	if _, err := kr.backend.get(newName); err == nil {
+		return ErrKeyExists
+	}
+
+	entry.Info.Name = newName
+	if err := kr.backend.set(entry); err != nil {
+		return err
+	}
+	return kr.backend.delete(oldName)
+}
+
+func (kr *keyring) Sign(name string, msg []byte) ([]byte, crypto.PubKey, error) {
+	kr.mtx.Lock()
+	entry, err := kr.backend.get(name)
+	kr.mtx.Unlock()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if entry.KeyStore == nil {
+		return nil, nil, fmt.Errorf("key %s of type %s can not sign", name, entry.Info.Type)
+	}
+
+	priv, err := kr.unlock(entry.KeyStore)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer ZeroPrivKey(priv)
+
+	sig, err := priv.Sign(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, entry.Info.PubKey, nil
+}
+
+func (kr *keyring) addKey(name string, keyType KeyType, privKey []byte, hdPath string) (KeyInfo, error) {
+	ks, err := NewKeyStore(privKey, kr.password, kr.kdf, kr.kdfParams)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+
+	pub := SecpPrivKeyGen(privKey).PubKey()
+	entry := keyringEntry{
+		Info: KeyInfo{
+			Name:      name,
+			Type:      keyType,
+			Address:   ks.Address,
+			PubKey:    pub,
+			HDPath:    hdPath,
+			CreatedAt: time.Now().UTC(),
+		},
+		KeyStore: ks,
+	}
+	return kr.add(entry)
+}
+
+func (kr *keyring) addPubKey(name string, keyType KeyType, pubkey crypto.PubKey) (KeyInfo, error) {
+	entry := keyringEntry{
+		Info: KeyInfo{
+			Name:      name,
+			Type:      keyType,
+			Address:   utils.CUAddressFromPubKey(pubkey).String(),
+			PubKey:    pubkey,
+			CreatedAt: time.Now().UTC(),
+		},
+	}
+	return kr.add(entry)
+}
+
+func (kr *keyring) add(entry keyringEntry) (KeyInfo, error) {
+	if err := validateKeyName(entry.Info.Name); err != nil {
+		return KeyInfo{}, err
+	}
+
+	kr.mtx.Lock()
+	defer kr.mtx.Unlock()
+
+	if _, err := kr.backend.get(entry.Info.Name); err == nil {
+		return KeyInfo{}, ErrKeyExists
+	}
+	if err := kr.backend.set(entry); err != nil {
+		return KeyInfo{}, err
+	}
+	return entry.Info, nil
+}
+
+func validateKeyName(name string) error {
+	if !reKeyName.MatchString(name) {
+		return fmt.Errorf("invalid key name: %q", name)
+	}
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// backends
+
+type memoryBackend struct {
+	entries map[string]keyringEntry
+}
+
+func (b *memoryBackend) get(name string) (keyringEntry, error) {
+	entry, ok := b.entries[name]
+	if !ok {
+		return keyringEntry{}, ErrKeyNotFound
+	}
+	return entry, nil
+}
+
+func (b *memoryBackend) set(entry keyringEntry) error {
+	b.entries[entry.Info.Name] = entry
+	return nil
+}
+
+func (b *memoryBackend) delete(name string) error {
+	delete(b.entries, name)
+	return nil
+}
+
+func (b *memoryBackend) list() ([]keyringEntry, error) {
+	entries := make([]keyringEntry, 0, len(b.entries))
+	for _, entry := range b.entries {
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+type dirBackend struct {
+	dir string
+}
+
+func (b *dirBackend) path(name string) string {
+	return filepath.Join(b.dir, name+keyringFileExt)
+}
+
+func (b *dirBackend) get(name string) (keyringEntry, error) {
+	if validateKeyName(name) != nil {
+		return keyringEntry{}, ErrKeyNotFound
+	}
+	return b.read(b.path(name))
+}
+
+func (b *dirBackend) read(path string) (keyringEntry, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return keyringEntry{}, ErrKeyNotFound
+	}
+	if err != nil {
+		return keyringEntry{}, err
+	}
+
+	var entry keyringEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return keyringEntry{}, err
+	}
+	return entry, nil
+}
+
+func (b *dirBackend) set(entry keyringEntry) error {
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(b.path(entry.Info.Name), data, 0600)
+}
+
+func (b *dirBackend) delete(name string) error {
+	return os.Remove(b.path(name))
+}
+
+func (b *dirBackend) list() ([]keyringEntry, error) {
+	files, err := ioutil.ReadDir(b.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []keyringEntry
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), keyringFileExt) {
+			continue
+		}
+		entry, err := b.read(filepath.Join(b.dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,163 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func testKeyring(t *testing.T, kr Keyring) {
+	prikeyByte, _ := hex.DecodeString("01ee5aa673f63fc906fb2dbc191438217c5e3f2646381b5e261be2d1f8479086")
+	prikeyByte2, _ := hex.DecodeString("1f118af86fcab84f1a7d5204e0cdda351dcf759498e3550a858b56fc719f5521")
+
+	local, err := kr.AddPrivKey("local", prikeyByte)
+	require.Nil(t, err)
+	require.Equal(t, KeyTypeLocal, local.Type)
+	address, _, _ := CreateAddress(prikeyByte)
+	require.Equal(t, address, local.Address)
+
+	_, err = kr.AddPrivKey("local", prikeyByte2)
+	require.Equal(t, ErrKeyExists, err)
+	_, err = kr.AddPrivKey("../local", prikeyByte2)
+	require.NotNil(t, err)
+
+	hd, err := kr.AddMnemonic("hd", testMnemonic, "", 0, 2)
+	require.Nil(t, err)
+	require.Equal(t, HDPath(0, 2), hd.HDPath)
+
+	pub2 := SecpPrivKeyGen(prikeyByte2).PubKey()
+	watch, err := kr.AddWatchOnly("watch", pub2)
+	require.Nil(t, err)
+
+	multi, err := kr.AddMultisig("multi", 2, []crypto.PubKey{local.PubKey, pub2})
+	require.Nil(t, err)
+	mulAddress, _, _ := GetMultiAddress([][]byte{prikeyByte, prikeyByte2})
+	require.Equal(t, mulAddress, multi.Address)
+
+	infos, err := kr.List()
+	require.Nil(t, err)
+	require.Len(t, infos, 4)
+	require.Equal(t, "hd", infos[0].Name)
+
+	shown, err := kr.Show("watch")
+	require.Nil(t, err)
+	require.Equal(t, watch.Address, shown.Address)
+	require.True(t, watch.PubKey.Equals(shown.PubKey))
+
+	shown, err = kr.ShowByAddress(multi.Address)
+	require.Nil(t, err)
+	require.Equal(t, "multi", shown.Name)
+
+	msg := []byte("my first message")
+	sig, pub, err := kr.Sign("local", msg)
+	require.Nil(t, err)
+	require.True(t, pub.VerifyBytes(msg, sig))
+	_, _, err = kr.Sign("watch", msg)
+	require.NotNil(t, err)
+
+	require.Nil(t, kr.Rename("local", "hot"))
+	_, err = kr.Show("local")
+	require.Equal(t, ErrKeyNotFound, err)
+	_, _, err = kr.Sign("hot", msg)
+	require.Nil(t, err)
+	require.Equal(t, ErrKeyExists, kr.Rename("hot", "hd"))
+
+	txData, err := CreateTransactionByName(kr, "hot", "hbc", watch.Address, "", "100000000", DefaultFee, 1)
+	require.Nil(t, err)
+	var sendData tx.SendData
+	require.Nil(t, tx.Cdc.UnmarshalJSON(txData, &sendData))
+	require.Len(t, sendData.Tx.Signatures, 1)
+	require.IsType(t, secp256k1.PubKeySecp256k1{}, sendData.Tx.Signatures[0].PubKey)
+
+	require.Nil(t, kr.Delete("hot"))
+	require.Equal(t, ErrKeyNotFound, kr.Delete("hot"))
+	infos, err = kr.List()
+	require.Nil(t, err)
+	require.Len(t, infos, 3)
+}
+
+func TestMemoryKeyring(t *testing.T) {
+	testKeyring(t, NewMemoryKeyring())
+}
+
+func TestTestKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	kr, err := NewTestKeyring(dir)
+	require.Nil(t, err)
+	testKeyring(t, kr)
+
+	// keys survive reopening the directory
+	kr, err = NewTestKeyring(dir)
+	require.Nil(t, err)
+	infos, err := kr.List()
+	require.Nil(t, err)
+	require.Len(t, infos, 3)
+	_, _, err = kr.Sign("hd", []byte("my first message"))
+	require.Nil(t, err)
+
+	// and can not be opened with another password
+	_, err = NewDirKeyring(dir, "password")
+	require.NotNil(t, err)
+}
+
+func TestDirKeyringKDF(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = NewDirKeyringWithKDF(dir, "password", KDFScrypt, KDFParams{N: 1 << 30})
+	require.NotNil(t, err)
+	_, err = NewDirKeyringWithKDF(dir, "password", "pbkdf2", KDFParams{})
+	require.NotNil(t, err)
+	_, err = NewDirKeyringWithKDF(dir, "", KDFArgon2id, testArgon2Params)
+	require.NotNil(t, err)
+
+	kr, err := NewDirKeyringWithKDF(dir, "password", KDFArgon2id, testArgon2Params)
+	require.Nil(t, err)
+	_, err = kr.AddWatchOnly("watch", SecpPrivKeyGen(make([]byte, 32)).PubKey())
+	require.Nil(t, err)
+	info, err := kr.AddMnemonic("hd", testMnemonic, "", 0, 0)
+	require.Nil(t, err)
+
+	// the key is derived once and reused by the next signatures
+	msg := []byte("my first message")
+	for i := 0; i < 2; i++ {
+		sig, pub, err := kr.Sign("hd", msg)
+		require.Nil(t, err)
+		require.True(t, pub.VerifyBytes(msg, sig))
+	}
+	require.Len(t, kr.(*keyring).keys, 1)
+
+	// clearing the cache zeroizes the derived keys
+	var cached []byte
+	for _, key := range kr.(*keyring).keys {
+		cached = key
+	}
+	kr.ClearKeyCache()
+	require.Len(t, kr.(*keyring).keys, 0)
+	require.Equal(t, make([]byte, len(cached)), cached)
+	_, _, err = kr.Sign("hd", msg)
+	require.Nil(t, err)
+	require.Len(t, kr.(*keyring).keys, 1)
+
+	// the password is checked when opening the keyring
+	_, err = NewDirKeyringWithKDF(dir, "wrong", KDFArgon2id, testArgon2Params)
+	require.NotNil(t, err)
+
+	kr, err = NewDirKeyring(dir, "password")
+	require.Nil(t, err)
+	require.Len(t, kr.(*keyring).keys, 1)
+	sig, pub, err := kr.Sign("hd", msg)
+	require.Nil(t, err)
+	require.True(t, pub.VerifyBytes(msg, sig))
+	require.True(t, info.PubKey.Equals(pub))
+}
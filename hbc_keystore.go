@@ -0,0 +1,438 @@
+package hbc
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	KeyStoreVersion = 1
+
+	KDFScrypt   = "scrypt"
+	KDFArgon2id = "argon2id"
+
+	CipherAESGCM = "aes-256-gcm"
+
+	kdfKeyLen  = 32
+	kdfSaltLen = 32
+)
+
+// Upper bounds of the kdf params accepted from a keystore file, so a crafted file
+// can not make the derivation exhaust the memory or the CPU.
+const (
+	MaxScryptN      = 1 << 20
+	MaxScryptR      = 32
+	MaxScryptP      = 16
+	MaxScryptMemory = 1 << 30 // 128 * N * r bytes
+
+	MaxArgon2Time    = 16
+	MaxArgon2Memory  = 1 << 20 // KiB
+	MaxArgon2Threads = 64
+)
+
+// KeyStore is an encrypted private key file.
+type KeyStore struct {
+	Version int            `json:"version"`
+	ID      string         `json:"id"`
+	Address string         `json:"address"`
+	PubKey  string         `json:"pubkey"`
+	Crypto  KeyStoreCrypto `json:"crypto"`
+}
+
+type KeyStoreCrypto struct {
+	Cipher       string               `json:"cipher"`
+	CipherText   string               `json:"ciphertext"`
+	CipherParams KeyStoreCipherParams `json:"cipherparams"`
+	KDF          string               `json:"kdf"`
+	KDFParams    KDFParams            `json:"kdfparams"`
+}
+
+type KeyStoreCipherParams struct {
+	Nonce string `json:"nonce"`
+}
+
+// KDFParams holds the parameters of the key derivation function.
+// Zero values are replaced by the defaults of the KDF.
+type KDFParams struct {
+	Salt   string `json:"salt"`
+	KeyLen int    `json:"dklen"`
+
+	// scrypt
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+
+	// argon2id
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+func (p KDFParams) withDefaults(kdf string) (KDFParams, error) {
+	p.KeyLen = kdfKeyLen
+	switch kdf {
+	case KDFScrypt:
+		if p.N == 0 {
+			p.N = 1 << 18
+		}
+		if p.R == 0 {
+			p.R = 8
+		}
+		if p.P == 0 {
+			p.P = 1
+		}
+	case KDFArgon2id:
+		if p.Time == 0 {
+			p.Time = 3
+		}
+		if p.Memory == 0 {
+			p.Memory = 64 * 1024
+		}
+		if p.Threads == 0 {
+			p.Threads = 4
+		}
+	default:
+		return p, fmt.Errorf("unsupported kdf: %v", kdf)
+	}
+	return p, nil
+}
+
+// Validate checks the params of kdf against the accepted bounds.
+func (p KDFParams) Validate(kdf string) error {
+	if p.KeyLen != kdfKeyLen {
+		return fmt.Errorf("invalid kdf key length: %v", p.KeyLen)
+	}
+
+	switch kdf {
+	case KDFScrypt:
+		if p.N <= 1 || p.N&(p.N-1) != 0 || p.N > MaxScryptN {
+			return fmt.Errorf("invalid scrypt n: %v, must be a power of 2 up to %v", p.N, MaxScryptN)
+		}
+		if p.R <= 0 || p.R > MaxScryptR {
+			return fmt.Errorf("invalid scrypt r: %v, must be in [1, %v]", p.R, MaxScryptR)
+		}
+		if p.P <= 0 || p.P > MaxScryptP {
+			return fmt.Errorf("invalid scrypt p: %v, must be in [1, %v]", p.P, MaxScryptP)
+		}
+		if 128*int64(p.N)*int64(p.R) > MaxScryptMemory {
+			return fmt.Errorf("scrypt n %v and r %v exceed the memory limit of %v bytes", p.N, p.R, MaxScryptMemory)
+		}
+	case KDFArgon2id:
+		if p.Time == 0 || p.Time > MaxArgon2Time {
+			return fmt.Errorf("invalid argon2 time: %v, must be in [1, %v]", p.Time, MaxArgon2Time)
+		}
+		if p.Memory == 0 || p.Memory > MaxArgon2Memory {
+			return fmt.Errorf("invalid argon2 memory: %v, must be in [1, %v] KiB", p.Memory, MaxArgon2Memory)
+		}
+		if p.Threads == 0 || p.Threads > MaxArgon2Threads {
+			return fmt.Errorf("invalid argon2 threads: %v, must be in [1, %v]", p.Threads, MaxArgon2Threads)
+		}
+	default:
+		return fmt.Errorf("unsupported kdf: %v", kdf)
+	}
+	return nil
+}
+
+func deriveKey(password string, kdf string, params KDFParams) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(salt) == 0 {
+		return nil, errors.New("invalid kdf params")
+	}
+	if err := params.Validate(kdf); err != nil {
+		return nil, err
+	}
+
+	switch kdf {
+	case KDFScrypt:
+		return scrypt.Key([]byte(password), salt, params.N, params.R, params.P, params.KeyLen)
+	case KDFArgon2id:
+		return argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, uint32(params.KeyLen)), nil
+	default:
+		return nil, fmt.Errorf("unsupported kdf: %v", kdf)
+	}
+}
+
+// NewKeyStore encrypts a raw secp256k1 private key with password.
+func NewKeyStore(privKey []byte, password string, kdf string, params KDFParams) (*KeyStore, error) {
+	if len(privKey) != 32 {
+		return nil, errors.New("invalid private key length")
+	}
+
+	priv := SecpPrivKeyGen(privKey)
+	pub := priv.PubKey()
+
+	ks := &KeyStore{
+		Version: KeyStoreVersion,
+		Address: utils.CUAddressFromPubKey(pub).String(),
+		PubKey:  utils.PubkeyToString(pub),
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	ks.ID = fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:])
+
+	if err := ks.encrypt(privKey, password, kdf, params); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// NewKeyStoreFromHex encrypts a hex encoded private key with password.
+func NewKeyStoreFromHex(privKeyHex string, password string, kdf string, params KDFParams) (*KeyStore, error) {
+	privKey, err := hex.DecodeString(privKeyHex)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(privKey)
+
+	return NewKeyStore(privKey, password, kdf, params)
+}
+
+// NewKeyStoreFromMnemonic encrypts the private key at 44'/496'/account'/0/index with password.
+func NewKeyStoreFromMnemonic(mnemonic, passphrase string, account, index uint32, password string, kdf string, params KDFParams) (*KeyStore, error) {
+	privKey, err := DerivePrivKeyFromMnemonic(mnemonic, passphrase, HDPath(account, index))
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(privKey)
+
+	return NewKeyStore(privKey, password, kdf, params)
+}
+
+// GenerateKeyStore creates a keystore holding a new random private key.
+func GenerateKeyStore(password string, kdf string, params KDFParams) (*KeyStore, error) {
+	priv := secp256k1.GenPrivKey()
+	defer zeroBytes(priv[:])
+
+	return NewKeyStore(priv[:], password, kdf, params)
+}
+
+// ParseKeyStore decodes a keystore from its JSON encoding.
+func ParseKeyStore(data []byte) (*KeyStore, error) {
+	var ks KeyStore
+	if err := json.Unmarshal(data, &ks); err != nil {
+		return nil, err
+	}
+	if ks.Version != KeyStoreVersion {
+		return nil, fmt.Errorf("unsupported keystore version: %v", ks.Version)
+	}
+	if ks.Crypto.Cipher != CipherAESGCM {
+		return nil, fmt.Errorf("unsupported cipher: %v", ks.Crypto.Cipher)
+	}
+	if !utils.IsValidAddr(ks.Address) {
+		return nil, fmt.Errorf("invalid keystore address: %v", ks.Address)
+	}
+	if err := ks.Crypto.KDFParams.Validate(ks.Crypto.KDF); err != nil {
+		return nil, err
+	}
+	return &ks, nil
+}
+
+// ReadKeyStore reads a keystore file.
+func ReadKeyStore(path string) (*KeyStore, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKeyStore(data)
+}
+
+// Marshal returns the JSON encoding of the keystore.
+func (ks *KeyStore) Marshal() ([]byte, error) {
+	return json.MarshalIndent(ks, "", "  ")
+}
+
+// WriteFile writes the keystore to path, readable by the owner only.
+func (ks *KeyStore) WriteFile(path string) error {
+	data, err := ks.Marshal()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+// Unlock decrypts the private key, a *secp256k1.PrivKeySecp256k1 so that it can be released
+// with ZeroPrivKey. Prefer WithUnlocked, which releases it.
+func (ks *KeyStore) Unlock(password string) (crypto.PrivKey, error) {
+	key, err := ks.deriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(key)
+
+	return ks.unlockWithKey(key)
+}
+
+func (ks *KeyStore) unlockWithKey(key []byte) (crypto.PrivKey, error) {
+	plain, err := ks.decryptWithKey(key)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(plain)
+
+	priv := new(secp256k1.PrivKeySecp256k1)
+	copy(priv[:], plain)
+
+	if utils.CUAddressFromPubKey(priv.PubKey()).String() != ks.Address {
+		ZeroPrivKey(priv)
+		return nil, errors.New("address not matched with privkey")
+	}
+	return priv, nil
+}
+
+// WithUnlocked decrypts the private key, calls fn with it and zeroizes it afterwards.
+// fn must not keep the key.
+func (ks *KeyStore) WithUnlocked(password string, fn func(priv crypto.PrivKey) error) error {
+	priv, err := ks.Unlock(password)
+	if err != nil {
+		return err
+	}
+	defer ZeroPrivKey(priv)
+
+	return fn(priv)
+}
+
+// ExportPrivKeyHex returns the hex encoded private key.
+func (ks *KeyStore) ExportPrivKeyHex(password string) (string, error) {
+	plain, err := ks.decrypt(password)
+	if err != nil {
+		return "", err
+	}
+	defer zeroBytes(plain)
+
+	return hex.EncodeToString(plain), nil
+}
+
+// ChangePassword re-encrypts the private key with newPassword, keeping the kdf.
+func (ks *KeyStore) ChangePassword(oldPassword, newPassword string) error {
+	plain, err := ks.decrypt(oldPassword)
+	if err != nil {
+		return err
+	}
+	defer zeroBytes(plain)
+
+	return ks.encrypt(plain, newPassword, ks.Crypto.KDF, ks.Crypto.KDFParams)
+}
+
+func (ks *KeyStore) encrypt(privKey []byte, password string, kdf string, params KDFParams) error {
+	params, err := params.withDefaults(kdf)
+	if err != nil {
+		return err
+	}
+
+	salt := make([]byte, kdfSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	params.Salt = hex.EncodeToString(salt)
+
+	key, err := deriveKey(password, kdf, params)
+	if err != nil {
+		return err
+	}
+	defer zeroBytes(key)
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	cipherText := gcm.Seal(nil, nonce, privKey, []byte(ks.Address))
+
+	ks.Crypto = KeyStoreCrypto{
+		Cipher:       CipherAESGCM,
+		CipherText:   hex.EncodeToString(cipherText),
+		CipherParams: KeyStoreCipherParams{Nonce: hex.EncodeToString(nonce)},
+		KDF:          kdf,
+		KDFParams:    params,
+	}
+	return nil
+}
+
+func (ks *KeyStore) decrypt(password string) ([]byte, error) {
+	key, err := ks.deriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(key)
+
+	return ks.decryptWithKey(key)
+}
+
+func (ks *KeyStore) deriveKey(password string) ([]byte, error) {
+	return deriveKey(password, ks.Crypto.KDF, ks.Crypto.KDFParams)
+}
+
+func (ks *KeyStore) decryptWithKey(key []byte) ([]byte, error) {
+	if ks.Crypto.Cipher != CipherAESGCM {
+		return nil, fmt.Errorf("unsupported cipher: %v", ks.Crypto.Cipher)
+	}
+
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hex.DecodeString(ks.Crypto.CipherParams.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, errors.New("invalid nonce length")
+	}
+
+	plain, err := gcm.Open(nil, nonce, cipherText, []byte(ks.Address))
+	if err != nil {
+		return nil, errors.New("could not decrypt key with given password")
+	}
+	return plain, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// ZeroPrivKey overwrites the bytes of a private key returned by KeyStore.Unlock.
+func ZeroPrivKey(priv crypto.PrivKey) {
+	if p, ok := priv.(*secp256k1.PrivKeySecp256k1); ok && p != nil {
+		zeroBytes(p[:])
+	}
+}
+
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,125 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+var (
+	testScryptParams = KDFParams{N: 1 << 10, R: 8, P: 1}
+	testArgon2Params = KDFParams{Time: 1, Memory: 1024, Threads: 1}
+)
+
+func TestKeyStore(t *testing.T) {
+	derivedPriStr := "da0bbe0acb8aae423de68a1a59379512d9d92ed453743592d6c3b2bc04252640"
+	prikeyByte, _ := hex.DecodeString(derivedPriStr)
+	address, _, err := CreateAddress(prikeyByte)
+	require.Nil(t, err)
+
+	for kdf, params := range map[string]KDFParams{KDFScrypt: testScryptParams, KDFArgon2id: testArgon2Params} {
+		ks, err := NewKeyStoreFromHex(derivedPriStr, "password", kdf, params)
+		require.Nil(t, err)
+		require.Equal(t, address, ks.Address)
+		require.Equal(t, kdf, ks.Crypto.KDF)
+
+		priv, err := ks.Unlock("password")
+		require.Nil(t, err)
+		require.True(t, priv.Equals(SecpPrivKeyGen(prikeyByte)))
+		require.Equal(t, prikeyByte, priv.(*secp256k1.PrivKeySecp256k1)[:])
+		ZeroPrivKey(priv)
+		require.Equal(t, make([]byte, 32), priv.(*secp256k1.PrivKeySecp256k1)[:])
+
+		// the key passed to fn is zeroized when fn returns
+		var unlocked crypto.PrivKey
+		require.Nil(t, ks.WithUnlocked("password", func(priv crypto.PrivKey) error {
+			unlocked = priv
+			require.Equal(t, prikeyByte, priv.(*secp256k1.PrivKeySecp256k1)[:])
+			return nil
+		}))
+		require.Equal(t, make([]byte, 32), unlocked.(*secp256k1.PrivKeySecp256k1)[:])
+
+		_, err = ks.Unlock("wrong")
+		require.NotNil(t, err)
+
+		require.Nil(t, ks.ChangePassword("password", "new password"))
+		_, err = ks.Unlock("password")
+		require.NotNil(t, err)
+		exported, err := ks.ExportPrivKeyHex("new password")
+		require.Nil(t, err)
+		require.Equal(t, derivedPriStr, exported)
+	}
+}
+
+func TestKeyStoreFile(t *testing.T) {
+	ks, err := NewKeyStoreFromMnemonic(testMnemonic, "", 0, 3, "password", KDFScrypt, testScryptParams)
+	require.Nil(t, err)
+	address, _, err := CreateAddressFromMnemonic(testMnemonic, "", 0, 3)
+	require.Nil(t, err)
+	require.Equal(t, address, ks.Address)
+
+	dir, err := ioutil.TempDir("", "keystore")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "key.json")
+	require.Nil(t, ks.WriteFile(path))
+
+	loaded, err := ReadKeyStore(path)
+	require.Nil(t, err)
+	require.Equal(t, ks, loaded)
+
+	err = loaded.WithUnlocked("password", func(priv crypto.PrivKey) error {
+		_, err := priv.Sign([]byte("my first message"))
+		return err
+	})
+	require.Nil(t, err)
+
+	// the ciphertext is bound to the address
+	loaded.Address = "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU"
+	_, err = loaded.Unlock("password")
+	require.NotNil(t, err)
+}
+
+func TestKeyStoreKDFBounds(t *testing.T) {
+	ks, err := NewKeyStoreFromHex("da0bbe0acb8aae423de68a1a59379512d9d92ed453743592d6c3b2bc04252640", "password", KDFScrypt, testScryptParams)
+	require.Nil(t, err)
+
+	for _, c := range []struct {
+		kdf    string
+		params KDFParams
+	}{
+		{KDFScrypt, KDFParams{N: 1 << 30, R: 8, P: 1}},
+		{KDFScrypt, KDFParams{N: 1000, R: 8, P: 1}},
+		{KDFScrypt, KDFParams{N: 1 << 20, R: 16, P: 1}},
+		{KDFScrypt, KDFParams{N: 1 << 10, R: 1 << 20, P: 1}},
+		{KDFScrypt, KDFParams{N: 1 << 10, R: 8, P: 1 << 20}},
+		{KDFArgon2id, KDFParams{Time: 1, Memory: 1 << 30, Threads: 1}},
+		{KDFArgon2id, KDFParams{Time: 1 << 20, Memory: 1024, Threads: 1}},
+		{KDFArgon2id, KDFParams{Time: 1, Memory: 1024, Threads: 255}},
+	} {
+		params := c.params
+		params.Salt = ks.Crypto.KDFParams.Salt
+		params.KeyLen = kdfKeyLen
+
+		crafted := *ks
+		crafted.Crypto.KDF = c.kdf
+		crafted.Crypto.KDFParams = params
+		_, err := crafted.Unlock("password")
+		require.NotNil(t, err, "%v %+v", c.kdf, c.params)
+
+		data, err := crafted.Marshal()
+		require.Nil(t, err)
+		_, err = ParseKeyStore(data)
+		require.NotNil(t, err, "%v %+v", c.kdf, c.params)
+
+		_, err = NewKeyStoreFromHex("da0bbe0acb8aae423de68a1a59379512d9d92ed453743592d6c3b2bc04252640", "password", c.kdf, c.params)
+		require.NotNil(t, err, "%v %+v", c.kdf, c.params)
+	}
+}
@@ -0,0 +1,27 @@
+package hbc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestLCD serves the response bodies of routes keyed by request path,
+// query strings are ignored.
+func newTestLCD(t *testing.T, routes map[string]string) (*Hbc, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"not found"}`))
+			return
+		}
+		w.Write([]byte(body))
+	}))
+
+	client, err := NewHbcClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, server.Close
+}
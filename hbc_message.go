@@ -0,0 +1,166 @@
+package hbc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+// MessageSignPrefix precedes the sign doc of an off-chain message. Transaction sign bytes are
+// a JSON object, so a message signature can never verify as a transaction signature.
+const MessageSignPrefix = "\x19HBC Signed Message:\n"
+
+// MessageSignDocType is the type of the sign doc of an off-chain message.
+const MessageSignDocType = "hbtcchain/MessageSignDoc"
+
+// MessageSignDoc is the signed content of an off-chain message.
+type MessageSignDoc struct {
+	Type   string          `json:"type"`
+	Signer utils.CUAddress `json:"signer"`
+	Data   []byte          `json:"data"`
+}
+
+// MessageSignBytes returns the deterministic bytes signed for data by signer.
+func MessageSignBytes(signer utils.CUAddress, data []byte) []byte {
+	bz, err := tx.Cdc.MarshalJSON(MessageSignDoc{
+		Type:   MessageSignDocType,
+		Signer: signer,
+		Data:   data,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return append([]byte(MessageSignPrefix), utils.MustSortJSON(bz)...)
+}
+
+// SignedMessage is an off-chain message with the signature proving the control of the signer address.
+type SignedMessage struct {
+	Signer    utils.CUAddress `json:"signer"`
+	Data      []byte          `json:"data"`
+	Signature tx.StdSignature `json:"signature"`
+}
+
+// SignBytes returns the bytes signed for the message.
+func (m SignedMessage) SignBytes() []byte {
+	return MessageSignBytes(m.Signer, m.Data)
+}
+
+// Bytes encodes the signed message to JSON.
+func (m SignedMessage) Bytes() ([]byte, error) {
+	return tx.Cdc.MarshalJSON(m)
+}
+
+// ParseSignedMessage decodes a signed message encoded with Bytes.
+func ParseSignedMessage(bz []byte) (*SignedMessage, error) {
+	var m SignedMessage
+	if err := tx.Cdc.UnmarshalJSON(bz, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// SignMessage signs data with priv for the address of its public key.
+func SignMessage(priv crypto.PrivKey, data []byte) (*SignedMessage, error) {
+	pub := priv.PubKey()
+	m := &SignedMessage{Signer: utils.CUAddressFromPubKey(pub), Data: data}
+
+	sig, err := priv.Sign(m.SignBytes())
+	if err != nil {
+		return nil, err
+	}
+	m.Signature = tx.StdSignature{PubKey: pub, Signature: sig}
+	return m, nil
+}
+
+// SignMessageByName is SignMessage signing with the key name of the keyring.
+func SignMessageByName(kr Keyring, name string, data []byte) (*SignedMessage, error) {
+	info, err := kr.Show(name)
+	if err != nil {
+		return nil, err
+	}
+	m := &SignedMessage{Signer: utils.CUAddressFromPubKey(info.PubKey), Data: data}
+
+	sig, pub, err := kr.Sign(name, m.SignBytes())
+	if err != nil {
+		return nil, err
+	}
+	m.Signature = tx.StdSignature{PubKey: pub, Signature: sig}
+	return m, nil
+}
+
+// NewMultisigMessage returns data to be signed for the address of the multisig public key,
+// the co-signers add their signatures with AddMultisigSignature.
+func NewMultisigMessage(mpk multisig.PubKeyMultisigThreshold, data []byte) *SignedMessage {
+	return &SignedMessage{
+		Signer: utils.CUAddressFromPubKey(mpk),
+		Data:   data,
+		Signature: tx.StdSignature{
+			PubKey:    mpk,
+			Signature: multisig.NewMultisig(len(mpk.PubKeys)).Marshal(),
+		},
+	}
+}
+
+// AddMultisigSignature adds the signature of priv, one of the keys of the multisig public key.
+func (m *SignedMessage) AddMultisigSignature(priv crypto.PrivKey) error {
+	sig, err := priv.Sign(m.SignBytes())
+	if err != nil {
+		return err
+	}
+	return m.addMultisigSignature(sig, priv.PubKey())
+}
+
+// AddMultisigSignatureByName is AddMultisigSignature signing with the key name of the keyring.
+func (m *SignedMessage) AddMultisigSignatureByName(kr Keyring, name string) error {
+	sig, pub, err := kr.Sign(name, m.SignBytes())
+	if err != nil {
+		return err
+	}
+	return m.addMultisigSignature(sig, pub)
+}
+
+func (m *SignedMessage) addMultisigSignature(sig []byte, pub crypto.PubKey) error {
+	mpk, ok := m.Signature.PubKey.(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		return errors.New("message is not signed with a multisig key")
+	}
+
+	var multisigSig multisig.Multisignature
+	if err := tx.Cdc.UnmarshalBinaryBare(m.Signature.Signature, &multisigSig); err != nil {
+		return err
+	}
+	if err := multisigSig.AddSignatureFromPubKey(sig, pub, mpk.PubKeys); err != nil {
+		return err
+	}
+	m.Signature.Signature = multisigSig.Marshal()
+	return nil
+}
+
+// VerifyMessage checks that m is signed for address by the key of address,
+// single or multisig, resolving the public key from the signature.
+func VerifyMessage(address string, m *SignedMessage) error {
+	addr, err := utils.CUAddressFromBase58(address)
+	if err != nil {
+		return err
+	}
+	if addr.Empty() || !m.Signer.Equals(addr) {
+		return fmt.Errorf("message signer %v is not %v", m.Signer, address)
+	}
+
+	pub := m.Signature.PubKey
+	if pub == nil {
+		return errors.New("message signature without public key")
+	}
+	if !utils.CUAddressFromPubKey(pub).Equals(addr) {
+		return fmt.Errorf("message public key does not match address %v", address)
+	}
+
+	if !pub.VerifyBytes(m.SignBytes(), m.Signature.Signature) {
+		return errors.New("verify message signature failed")
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package hbc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+func TestSignMessage(t *testing.T) {
+	prikeyByte, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	priv := SecpPrivKeyGen(prikeyByte)
+	address, _, err := CreateAddress(prikeyByte)
+	require.Nil(t, err)
+
+	data := []byte("I control this address")
+	m, err := SignMessage(priv, data)
+	require.Nil(t, err)
+	require.Equal(t, address, m.Signer.String())
+	require.Nil(t, VerifyMessage(address, m))
+
+	signBytes := m.SignBytes()
+	require.True(t, bytes.HasPrefix(signBytes, []byte(MessageSignPrefix)))
+	require.Equal(t, signBytes, MessageSignBytes(m.Signer, data))
+
+	bz, err := m.Bytes()
+	require.Nil(t, err)
+	parsed, err := ParseSignedMessage(bz)
+	require.Nil(t, err)
+	require.Nil(t, VerifyMessage(address, parsed))
+
+	// another address
+	other := SecpPrivKeyGen([]byte("other"))
+	require.NotNil(t, VerifyMessage(utils.CUAddressFromPubKey(other.PubKey()).String(), m))
+
+	// tampered data
+	parsed.Data = []byte("I control another address")
+	require.NotNil(t, VerifyMessage(address, parsed))
+
+	// signer and public key mismatch
+	forged, err := SignMessage(other, data)
+	require.Nil(t, err)
+	forged.Signer = m.Signer
+	require.NotNil(t, VerifyMessage(address, forged))
+
+	// a transaction signature is no message signature
+	txSig, err := priv.Sign(MessageSignBytes(m.Signer, data)[len(MessageSignPrefix):])
+	require.Nil(t, err)
+	forged = &SignedMessage{Signer: m.Signer, Data: data, Signature: m.Signature}
+	forged.Signature.Signature = txSig
+	require.NotNil(t, VerifyMessage(address, forged))
+}
+
+func TestSignMessageByName(t *testing.T) {
+	prikeyByte, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	kr := NewMemoryKeyring()
+	info, err := kr.AddPrivKey("local", prikeyByte)
+	require.Nil(t, err)
+
+	m, err := SignMessageByName(kr, "local", []byte("hello"))
+	require.Nil(t, err)
+	require.Nil(t, VerifyMessage(info.Address, m))
+}
+
+func TestSignMultisigMessage(t *testing.T) {
+	var privs []crypto.PrivKey
+	var pubs []crypto.PubKey
+	for _, s := range []string{
+		"01ee5aa673f63fc906fb2dbc191438217c5e3f2646381b5e261be2d1f8479086",
+		"1f118af86fcab84f1a7d5204e0cdda351dcf759498e3550a858b56fc719f5521",
+		"8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908",
+	} {
+		bz, _ := hex.DecodeString(s)
+		privs = append(privs, SecpPrivKeyGen(bz))
+		pubs = append(pubs, privs[len(privs)-1].PubKey())
+	}
+	mpk := multisig.PubKeyMultisigThreshold{K: 2, PubKeys: pubs}
+	address := utils.CUAddressFromPubKey(mpk).String()
+
+	m := NewMultisigMessage(mpk, []byte("we control this address"))
+	require.Nil(t, m.AddMultisigSignature(privs[0]))
+	require.NotNil(t, VerifyMessage(address, m))
+
+	// pass the partially signed message to the next co-signer
+	bz, err := m.Bytes()
+	require.Nil(t, err)
+	m, err = ParseSignedMessage(bz)
+	require.Nil(t, err)
+	require.Nil(t, m.AddMultisigSignature(privs[2]))
+	require.Nil(t, VerifyMessage(address, m))
+
+	require.NotNil(t, m.AddMultisigSignature(SecpPrivKeyGen([]byte("other"))))
+
+	single, err := SignMessage(privs[0], m.Data)
+	require.Nil(t, err)
+	require.NotNil(t, single.AddMultisigSignature(privs[1]))
+	require.NotNil(t, VerifyMessage(address, single))
+}
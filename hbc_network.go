@@ -0,0 +1,287 @@
+package hbc
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/base58"
+	"gopkg.in/yaml.v2"
+)
+
+// Network is the profile of a chain, clients and TxBuilders each carry their own.
+type Network struct {
+	Name      string   `json:"name" yaml:"name"`
+	ChainID   string   `json:"chain_id" yaml:"chain_id"`
+	FeeDenom  string   `json:"fee_denom" yaml:"fee_denom"`
+	MinFee    string   `json:"min_fee" yaml:"min_fee"`
+	GasLimit  uint64   `json:"gas_limit" yaml:"gas_limit"`
+	Decimals  uint64   `json:"decimals" yaml:"decimals"`
+	Endpoints []string `json:"endpoints" yaml:"endpoints"`
+	// AddrPrefix is the base58 prefix of the network addresses, "HBC" when empty.
+	AddrPrefix string `json:"addr_prefix,omitempty" yaml:"addr_prefix,omitempty"`
+	// ExtNetwork is the network of the external chains withdrawal addresses are checked for.
+	ExtNetwork utils.ExtNetwork `json:"ext_network,omitempty" yaml:"ext_network,omitempty"`
+	// FeeOracle is the url of the explorer default fee, see GetHbcGas.
+	FeeOracle string `json:"fee_oracle,omitempty" yaml:"fee_oracle,omitempty"`
+}
+
+// MainnetNetwork returns the profile of the HBC mainnet. It ships without endpoints, supply the
+// LCD endpoints of your nodes with WithEndpoints before building a client with NewHbcWithNetwork.
+func MainnetNetwork() Network {
+	return Network{
+		Name:       "mainnet",
+		ChainID:    "hbtc-mainnet",
+		FeeDenom:   "hbc",
+		MinFee:     "1000000000000",
+		GasLimit:   2000000,
+		Decimals:   18,
+		ExtNetwork: utils.ExtMainnet,
+		FeeOracle:  oracleUrl,
+	}
+}
+
+// TestnetNetwork returns the profile of the HBC testnet. Like MainnetNetwork it ships without
+// endpoints, see WithEndpoints.
+func TestnetNetwork() Network {
+	return Network{
+		Name:       "testnet",
+		ChainID:    "hbtc-testnet",
+		FeeDenom:   "hbc",
+		MinFee:     "1000000000000",
+		GasLimit:   2000000,
+		Decimals:   18,
+		ExtNetwork: utils.ExtTestnet,
+	}
+}
+
+// DefaultNetwork returns the profile of the package defaults, DefaultChainID etc.
+func DefaultNetwork() Network {
+	return Network{
+		Name:       "default",
+		ChainID:    DefaultChainID,
+		FeeDenom:   DefaultTokenId,
+		MinFee:     DefaultFee,
+		GasLimit:   uint64(DefaultGasLimit),
+		Decimals:   uint64(DefaultDecimals),
+		ExtNetwork: utils.ExtTestnet,
+	}
+}
+
+// ParseNetwork decodes a YAML or JSON network profile.
+func ParseNetwork(bz []byte) (*Network, error) {
+	var network Network
+	err := yaml.Unmarshal(bz, &network)
+	if err != nil {
+		return nil, err
+	}
+	if err := network.Validate(); err != nil {
+		return nil, err
+	}
+	return &network, nil
+}
+
+// LoadNetwork reads a YAML or JSON network profile file.
+func LoadNetwork(path string) (*Network, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNetwork(bz)
+}
+
+func (n Network) Validate() error {
+	if n.ChainID == "" {
+		return errors.New("network without chain id")
+	}
+	if err := utils.ValidateDenom(n.FeeDenom); err != nil {
+		return err
+	}
+	if _, err := n.minFee(); err != nil {
+		return err
+	}
+	if n.GasLimit == 0 {
+		return errors.New("network without gas limit")
+	}
+	if n.Decimals > utils.MaxTokenDecimals {
+		return fmt.Errorf("network decimals %v exceeds %v", n.Decimals, utils.MaxTokenDecimals)
+	}
+	if err := n.ExtNetwork.Validate(); err != nil {
+		return err
+	}
+	if _, err := n.AddressPrefix(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddressPrefix returns the prefix of the network addresses.
+func (n Network) AddressPrefix() (base58.AddrPrefix, error) {
+	if n.AddrPrefix == "" {
+		return base58.DefaultAddrPrefix, nil
+	}
+	return base58.NewAddrPrefix(n.AddrPrefix)
+}
+
+// ParseAddress decodes an address of the network.
+func (n Network) ParseAddress(address string) (utils.CUAddress, error) {
+	prefix, err := n.AddressPrefix()
+	if err != nil {
+		return nil, err
+	}
+	return utils.CUAddressFromBase58WithPrefix(address, prefix)
+}
+
+// FormatAddress encodes addr as an address of the network, "" for an invalid address prefix.
+func (n Network) FormatAddress(addr utils.CUAddress) string {
+	prefix, err := n.AddressPrefix()
+	if err != nil {
+		return ""
+	}
+	return addr.StringWithPrefix(prefix)
+}
+
+// ValidateAddress checks an address of the network, see utils.ValidateAddr.
+func (n Network) ValidateAddress(address string) error {
+	prefix, err := n.AddressPrefix()
+	if err != nil {
+		return err
+	}
+	return utils.ValidateAddrWithPrefix(address, prefix)
+}
+
+// checkTxAddrPrefix checks that transactions, which encode addresses with the process wide
+// prefix of utils.InitAddrPrefix, carry the addresses of the network.
+func (n Network) checkTxAddrPrefix() error {
+	prefix, err := n.AddressPrefix()
+	if err != nil {
+		return err
+	}
+	if current := base58.CurrentAddrPrefix(); !prefix.Equal(current) {
+		return fmt.Errorf("network %v addresses are prefixed with %v, transactions with %v, see utils.InitAddrPrefix", n.Name, prefix.Str, current.Str)
+	}
+	return nil
+}
+
+func (n Network) minFee() (sdk.Int, error) {
+	minFee, ok := sdk.NewIntFromString(n.MinFee)
+	if !ok || minFee.IsNegative() {
+		return sdk.Int{}, fmt.Errorf("error network min fee: %v", n.MinFee)
+	}
+	return minFee, nil
+}
+
+// TxParams returns the parameters transactions of the network are built with.
+func (n Network) TxParams() (TxParams, error) {
+	minFee, err := n.minFee()
+	if err != nil {
+		return TxParams{}, err
+	}
+
+	return TxParams{
+		ChainID:  n.ChainID,
+		FeeDenom: n.FeeDenom,
+		MinFee:   minFee,
+		GasLimit: n.GasLimit,
+	}, nil
+}
+
+// WithEndpoints returns the network with the LCD endpoints, e.g. of a preset.
+func (n Network) WithEndpoints(endpoints ...string) Network {
+	n.Endpoints = append([]string(nil), endpoints...)
+	return n
+}
+
+// NewHbcWithNetwork returns a client of network connected to its first endpoint.
+func NewHbcWithNetwork(network Network) (*Hbc, error) {
+	if len(network.Endpoints) == 0 {
+		return nil, fmt.Errorf("network %v without endpoints, supply them with WithEndpoints", network.Name)
+	}
+	return NewHbcClientWithNetwork(network.Endpoints[0], network)
+}
+
+// NewHbcClientWithNetwork returns a client of network connected to url.
+func NewHbcClientWithNetwork(url string, network Network) (*Hbc, error) {
+	if err := network.Validate(); err != nil {
+		return nil, err
+	}
+
+	hbc, err := NewHbcClient(url)
+	if err != nil {
+		return nil, err
+	}
+	hbc.Network = network
+	return hbc, nil
+}
+
+// TxBuilder builds and signs transactions for its Network.
+type TxBuilder struct {
+	Network Network
+	// Params, e.g. read from the chain by GetTxParams, replace the ones of the Network,
+	// the fee never goes below the Network MinFee.
+	Params *TxParams
+}
+
+func NewTxBuilder(network Network) *TxBuilder {
+	return &TxBuilder{Network: network}
+}
+
+// DefaultTxBuilder returns a TxBuilder of DefaultNetwork, as used by the package level functions.
+func DefaultTxBuilder() *TxBuilder {
+	return NewTxBuilder(DefaultNetwork())
+}
+
+// TxBuilder returns a TxBuilder of the client network.
+func (hbc *Hbc) TxBuilder() *TxBuilder {
+	return NewTxBuilder(hbc.network())
+}
+
+// ValidateAddress checks an address of the client network.
+func (hbc *Hbc) ValidateAddress(address string) error {
+	return hbc.network().ValidateAddress(address)
+}
+
+// ChainTxBuilder returns a TxBuilder of the client network building transactions with the
+// TxParams of the chain, see GetTxParams.
+func (hbc *Hbc) ChainTxBuilder() (*TxBuilder, error) {
+	params, err := hbc.GetTxParams()
+	if err != nil {
+		return nil, err
+	}
+	b := hbc.TxBuilder()
+	b.Params = params
+	return b, nil
+}
+
+// txParams returns the Params, completed and floored with the ones of the Network.
+func (b *TxBuilder) txParams() (TxParams, error) {
+	params, err := b.Network.TxParams()
+	if err != nil || b.Params == nil {
+		return params, err
+	}
+
+	chainParams := *b.Params
+	if chainParams.ChainID == "" {
+		chainParams.ChainID = params.ChainID
+	}
+	if chainParams.FeeDenom == "" {
+		chainParams.FeeDenom = params.FeeDenom
+	}
+	if utils.IsNilInt(chainParams.MinFee) || chainParams.MinFee.LT(params.MinFee) {
+		chainParams.MinFee = params.MinFee
+	}
+	if chainParams.GasLimit == 0 {
+		chainParams.GasLimit = params.GasLimit
+	}
+	return chainParams, nil
+}
+
+// network returns the client network, DefaultNetwork for clients built without one.
+func (hbc *Hbc) network() Network {
+	if hbc.Network.ChainID == "" {
+		return DefaultNetwork()
+	}
+	return hbc.Network
+}
@@ -0,0 +1,134 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func TestNetworkProfiles(t *testing.T) {
+	require.Nil(t, MainnetNetwork().Validate())
+	require.Nil(t, TestnetNetwork().Validate())
+	require.Nil(t, DefaultNetwork().Validate())
+
+	network, err := ParseNetwork([]byte("name: local\nchain_id: hbtc-local\nfee_denom: hbc\nmin_fee: \"5\"\ngas_limit: 300000\ndecimals: 18\nendpoints:\n  - http://127.0.0.1:1317\n"))
+	require.Nil(t, err)
+	require.Equal(t, "hbtc-local", network.ChainID)
+	require.Equal(t, uint64(300000), network.GasLimit)
+
+	dir, err := ioutil.TempDir("", "network")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "network.json")
+	require.Nil(t, ioutil.WriteFile(path, []byte(`{"name":"local","chain_id":"hbtc-local","fee_denom":"hbc","min_fee":"5","gas_limit":300000,"decimals":18,"endpoints":["http://127.0.0.1:1317"]}`), 0600))
+	loaded, err := LoadNetwork(path)
+	require.Nil(t, err)
+	require.Equal(t, network, loaded)
+
+	_, err = ParseNetwork([]byte("chain_id: hbtc-local\nfee_denom: hbc\nmin_fee: five\ngas_limit: 1\n"))
+	require.NotNil(t, err)
+	_, err = ParseNetwork([]byte("fee_denom: hbc\nmin_fee: \"5\"\ngas_limit: 1\n"))
+	require.NotNil(t, err)
+
+	client, err := NewHbcWithNetwork(*network)
+	require.Nil(t, err)
+	require.Equal(t, "http://127.0.0.1:1317", client.RestUrl)
+	require.Equal(t, "hbtc-local", client.TxBuilder().Network.ChainID)
+
+	// the presets ship without endpoints
+	_, err = NewHbcWithNetwork(MainnetNetwork())
+	require.NotNil(t, err)
+	mainnet := MainnetNetwork().WithEndpoints("http://127.0.0.1:1317")
+	client, err = NewHbcWithNetwork(mainnet)
+	require.Nil(t, err)
+	require.Equal(t, "http://127.0.0.1:1317", client.RestUrl)
+	require.Equal(t, "hbtc-mainnet", client.TxBuilder().Network.ChainID)
+	require.Empty(t, MainnetNetwork().Endpoints)
+}
+
+func TestTxBuilderNetworks(t *testing.T) {
+	priKey, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	address, _, err := CreateAddress(priKey)
+	require.Nil(t, err)
+
+	networks := []Network{MainnetNetwork(), TestnetNetwork()}
+	errs := make(chan error, len(networks))
+	for _, network := range networks {
+		go func(network Network) {
+			errs <- checkTxBuilderNetwork(network, priKey, address)
+		}(network)
+	}
+	for range networks {
+		require.Nil(t, <-errs)
+	}
+}
+
+func checkTxBuilderNetwork(network Network, priKey []byte, address string) error {
+	txData, err := NewTxBuilder(network).CreateTransaction("hbc", priKey, address, address, "", "1", "0", 3)
+	if err != nil {
+		return err
+	}
+
+	var sendData tx.SendData
+	if err := tx.Cdc.UnmarshalJSON(txData, &sendData); err != nil {
+		return err
+	}
+	stdTx := sendData.Tx
+	sig := stdTx.Signatures[0]
+	if !sig.PubKey.VerifyBytes(tx.StdSignBytes(network.ChainID, 3, stdTx.Msgs, stdTx.Memo, stdTx.Fee), sig.Signature) {
+		return fmt.Errorf("tx of %v is not signed for %v", network.Name, network.ChainID)
+	}
+	if stdTx.Fee.Gas != network.GasLimit {
+		return fmt.Errorf("tx of %v with gas %v, want %v", network.Name, stdTx.Fee.Gas, network.GasLimit)
+	}
+	return nil
+}
+
+func TestNetworkAddrPrefix(t *testing.T) {
+	priKey, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	address, _, err := CreateAddress(priKey)
+	require.Nil(t, err)
+	cuAddr, err := utils.CUAddressFromBase58(address)
+	require.Nil(t, err)
+
+	bhb := TestnetNetwork()
+	bhb.AddrPrefix = "BHB"
+	require.Nil(t, bhb.Validate())
+	hbc := TestnetNetwork()
+
+	// clients of both networks coexist
+	bhbClient, err := NewHbcClientWithNetwork("http://127.0.0.1:1317", bhb)
+	require.Nil(t, err)
+	hbcClient, err := NewHbcClientWithNetwork("http://127.0.0.1:1318", hbc)
+	require.Nil(t, err)
+
+	bhbAddress := bhb.FormatAddress(cuAddr)
+	require.Equal(t, "BHB", bhbAddress[:3])
+	require.Equal(t, address, hbc.FormatAddress(cuAddr))
+	parsed, err := bhb.ParseAddress(bhbAddress)
+	require.Nil(t, err)
+	require.Equal(t, cuAddr, parsed)
+	_, err = bhb.ParseAddress(address)
+	require.NotNil(t, err)
+
+	require.Nil(t, bhbClient.ValidateAddress(bhbAddress))
+	require.NotNil(t, bhbClient.ValidateAddress(address))
+	require.Nil(t, hbcClient.ValidateAddress(address))
+	require.NotNil(t, hbcClient.ValidateAddress(bhbAddress))
+
+	// transactions encode addresses with the process wide prefix
+	_, err = hbcClient.TxBuilder().CreateTransaction("hbc", priKey, address, address, "", "1", "0", 1)
+	require.Nil(t, err)
+	_, err = bhbClient.TxBuilder().CreateTransaction("hbc", priKey, address, address, "", "1", "0", 1)
+	require.NotNil(t, err)
+
+	bhb.AddrPrefix = "0x"
+	require.NotNil(t, bhb.Validate())
+}
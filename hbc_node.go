@@ -0,0 +1,174 @@
+package hbc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+// ErrChainIDMismatch is returned when a transaction is not signed for the network of the node.
+var ErrChainIDMismatch = errors.New("chain id mismatch")
+
+// MaxQueuedTxs is how many sequences ahead of its account on chain a transaction may be
+// signed at, queued behind transactions not yet included in a block.
+const MaxQueuedTxs = 16
+
+type ProtocolVersion struct {
+	P2P   uint64 `json:"p2p,string"`
+	Block uint64 `json:"block,string"`
+	App   uint64 `json:"app,string"`
+}
+
+type NodeInfo struct {
+	ProtocolVersion ProtocolVersion `json:"protocol_version"`
+	ID              string          `json:"id"`
+	ListenAddr      string          `json:"listen_addr"`
+	Network         string          `json:"network"`
+	Version         string          `json:"version"`
+	Channels        string          `json:"channels"`
+	Moniker         string          `json:"moniker"`
+	Other           struct {
+		TxIndex    string `json:"tx_index"`
+		RPCAddress string `json:"rpc_address"`
+	} `json:"other"`
+}
+
+type ApplicationVersion struct {
+	Name       string `json:"name"`
+	ServerName string `json:"server_name"`
+	ClientName string `json:"client_name"`
+	Version    string `json:"version"`
+	Commit     string `json:"commit"`
+	BuildTags  string `json:"build_tags"`
+	GoVersion  string `json:"go"`
+}
+
+type NodeInfoData struct {
+	NodeInfo           NodeInfo           `json:"node_info"`
+	ApplicationVersion ApplicationVersion `json:"application_version"`
+}
+
+// Network returns the chain id of the node.
+func (d NodeInfoData) Network() string { return d.NodeInfo.Network }
+
+// Version returns the tendermint version of the node.
+func (d NodeInfoData) Version() string { return d.NodeInfo.Version }
+
+// AppVersion returns the version of the chain application of the node.
+func (d NodeInfoData) AppVersion() string { return d.ApplicationVersion.Version }
+
+func (hbc *Hbc) GetNodeInfo() (*NodeInfoData, error) {
+	var response NodeInfoData
+	err := hbc.RequestHbcData("GET", "/node_info", map[string]interface {
+	}{}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetNetwork returns the chain id of the node.
+func (hbc *Hbc) GetNetwork() (string, error) {
+	nodeInfo, err := hbc.GetNodeInfo()
+	if err != nil {
+		return "", err
+	}
+	return nodeInfo.Network(), nil
+}
+
+// IsSyncing checks if the node is catching up with the chain.
+func (hbc *Hbc) IsSyncing() (bool, error) {
+	var response struct {
+		Syncing bool `json:"syncing"`
+	}
+	err := hbc.RequestHbcData("GET", "/syncing", map[string]interface {
+	}{}, &response)
+	if err != nil {
+		return false, err
+	}
+	return response.Syncing, nil
+}
+
+// CheckNetworkChainID checks that the network of the node is the chain id the client
+// TxBuilder signs transactions for.
+func (hbc *Hbc) CheckNetworkChainID() error {
+	network, err := hbc.GetNetwork()
+	if err != nil {
+		return err
+	}
+	chainID := hbc.TxBuilder().Network.ChainID
+	if network != chainID {
+		return fmt.Errorf("%w: client signs for %v, node is on %v", ErrChainIDMismatch, chainID, network)
+	}
+	return nil
+}
+
+// CheckTxChainID checks that txData, as built by CreateTransaction, is signed at sequence for
+// the network of the node. The chain id is not part of a signed transaction, so the first
+// signature is verified against the network and the sequence the transaction was signed at.
+func (hbc *Hbc) CheckTxChainID(txData []byte, sequence int64) error {
+	stdTx, err := decodeSignedTx(txData)
+	if err != nil {
+		return err
+	}
+	network, err := hbc.GetNetwork()
+	if err != nil {
+		return err
+	}
+
+	if !verifyTxChainID(stdTx, network, sequence) {
+		return fmt.Errorf("%w: tx is not signed for network %v of the node at sequence %v", ErrChainIDMismatch, network, sequence)
+	}
+	return nil
+}
+
+// CheckSignedTxChainID is CheckTxChainID at the sequence of the signer on chain, or one of
+// the MaxQueuedTxs next ones.
+func (hbc *Hbc) CheckSignedTxChainID(txData []byte) error {
+	stdTx, err := decodeSignedTx(txData)
+	if err != nil {
+		return err
+	}
+	network, err := hbc.GetNetwork()
+	if err != nil {
+		return err
+	}
+
+	var sequence int64
+	signer := utils.CUAddressFromPubKey(stdTx.Signatures[0].PubKey).String()
+	cu, err := hbc.GetCU(signer)
+	switch {
+	case err == nil:
+		sequence = int64(cu.Sequence)
+	case !errors.Is(err, ErrCUNotFound):
+		return err
+	}
+
+	for i := int64(0); i <= MaxQueuedTxs; i++ {
+		if verifyTxChainID(stdTx, network, sequence+i) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: tx is not signed for network %v of the node at sequences %v to %v of %v",
+		ErrChainIDMismatch, network, sequence, sequence+MaxQueuedTxs, signer)
+}
+
+func decodeSignedTx(txData []byte) (tx.StdTx, error) {
+	var sendData tx.SendData
+	if err := tx.Cdc.UnmarshalJSON(txData, &sendData); err != nil {
+		return tx.StdTx{}, err
+	}
+	stdTx := sendData.Tx
+	if len(stdTx.Msgs) == 0 || len(stdTx.Signatures) == 0 || stdTx.Signatures[0].PubKey == nil {
+		return tx.StdTx{}, errors.New("tx without msgs or signatures")
+	}
+	return stdTx, nil
+}
+
+func verifyTxChainID(stdTx tx.StdTx, network string, sequence int64) bool {
+	sig := stdTx.Signatures[0]
+	signBytes := tx.StdSignBytes(network, sequence, stdTx.Msgs, stdTx.Memo, stdTx.Fee)
+	return sig.PubKey.VerifyBytes(signBytes, sig.Signature)
+}
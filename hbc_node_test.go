@@ -0,0 +1,83 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeInfo(t *testing.T) {
+	client, closer := newTestLCD(t, map[string]string{
+		"/node_info": `{"node_info":{"protocol_version":{"p2p":"7","block":"10","app":"0"},"id":"3b3e8c5a","listen_addr":"tcp://0.0.0.0:26656","network":"hbtc-testnet","version":"0.33.3","channels":"4020212223303800","moniker":"node0","other":{"tx_index":"on","rpc_address":"tcp://0.0.0.0:26657"}},"application_version":{"name":"hbtcchain","server_name":"hbtcd","client_name":"hbtccli","version":"1.2.0","commit":"abc","build_tags":"netgo","go":"go1.13"}}`,
+		"/syncing":   `{"syncing":true}`,
+	})
+	defer closer()
+
+	nodeInfo, err := client.GetNodeInfo()
+	require.Nil(t, err)
+	require.Equal(t, "hbtc-testnet", nodeInfo.Network())
+	require.Equal(t, "0.33.3", nodeInfo.Version())
+	require.Equal(t, "1.2.0", nodeInfo.AppVersion())
+	require.Equal(t, uint64(10), nodeInfo.NodeInfo.ProtocolVersion.Block)
+
+	syncing, err := client.IsSyncing()
+	require.Nil(t, err)
+	require.True(t, syncing)
+}
+
+func TestCheckTxChainID(t *testing.T) {
+	priKey, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	address, _, err := CreateAddress(priKey)
+	require.Nil(t, err)
+
+	// the signer is at sequence 5 on chain, the tx is queued behind another one
+	txData, err := CreateTransaction(DefaultTokenId, priKey, address, address, "", "1", DefaultFee, 6)
+	require.Nil(t, err)
+
+	testnet, closeTestnet := newTestLCD(t, map[string]string{
+		"/node_info":         `{"node_info":{"network":"` + DefaultChainID + `"},"application_version":{}}`,
+		"/cu/cus/" + address: `{"height":"1","result":{"type":"hbtcchain/CustodianUnit","value":{"cu_type":1,"address":"` + address + `","sequence":"5"}}}`,
+		"/txs":               `{"height":"0","txhash":"AB12"}`,
+	})
+	defer closeTestnet()
+	mainnet, closeMainnet := newTestLCD(t, map[string]string{
+		"/node_info":         `{"node_info":{"network":"hbtc-mainnet"},"application_version":{}}`,
+		"/cu/cus/" + address: `{"height":"1","result":{"type":"hbtcchain/CustodianUnit","value":{"cu_type":1,"address":"` + address + `","sequence":"5"}}}`,
+		"/txs":               `{"height":"0","txhash":"AB12"}`,
+	})
+	defer closeMainnet()
+
+	require.Nil(t, testnet.CheckTxChainID(txData, 6))
+	err = testnet.CheckTxChainID(txData, 5)
+	require.True(t, errors.Is(err, ErrChainIDMismatch))
+	err = mainnet.CheckTxChainID(txData, 6)
+	require.True(t, errors.Is(err, ErrChainIDMismatch))
+
+	require.Nil(t, testnet.CheckNetworkChainID())
+	err = mainnet.CheckNetworkChainID()
+	require.True(t, errors.Is(err, ErrChainIDMismatch))
+
+	// the signature is checked against the network of the node before broadcasting
+	hash, err := testnet.SendSignedTx(txData)
+	require.Nil(t, err)
+	require.Equal(t, "AB12", hash)
+	_, err = mainnet.SendSignedTx(txData)
+	require.True(t, errors.Is(err, ErrChainIDMismatch))
+
+	// too far ahead of the signer sequence
+	farTxData, err := CreateTransaction(DefaultTokenId, priKey, address, address, "", "1", DefaultFee, 5+MaxQueuedTxs+1)
+	require.Nil(t, err)
+	_, err = testnet.SendSignedTx(farTxData)
+	require.True(t, errors.Is(err, ErrChainIDMismatch))
+
+	mainnetTxData, err := NewTxBuilder(MainnetNetwork()).CreateTransaction(DefaultTokenId, priKey, address, address, "", "1", DefaultFee, 5)
+	require.Nil(t, err)
+	_, err = mainnet.SendSignedTx(mainnetTxData)
+	require.Nil(t, err)
+
+	mainnet.SkipChainIDCheck = true
+	_, err = mainnet.SendSignedTx(txData)
+	require.Nil(t, err)
+}
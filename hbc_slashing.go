@@ -0,0 +1,80 @@
+package hbc
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+type ValidatorSigningInfo struct {
+	Address             utils.ConsAddress `json:"address"`
+	StartHeight         int64             `json:"start_height,string"`
+	IndexOffset         int64             `json:"index_offset,string"`
+	JailedUntil         time.Time         `json:"jailed_until"`
+	Tombstoned          bool              `json:"tombstoned"`
+	MissedBlocksCounter int64             `json:"missed_blocks_counter,string"`
+}
+
+// IsJailed checks if the validator is still jailed at now.
+func (info ValidatorSigningInfo) IsJailed(now time.Time) bool {
+	return now.Before(info.JailedUntil)
+}
+
+// Uptime returns the share of blocks signed in the current signing window.
+func (info ValidatorSigningInfo) Uptime(params SlashingParams) (sdk.Dec, error) {
+	if params.SignedBlocksWindow <= 0 {
+		return sdk.Dec{}, errors.New("signed blocks window must be positive")
+	}
+	missed := info.MissedBlocksCounter
+	if missed > params.SignedBlocksWindow {
+		missed = params.SignedBlocksWindow
+	}
+	return sdk.OneDec().Sub(sdk.NewDec(missed).QuoInt64(params.SignedBlocksWindow)), nil
+}
+
+type SlashingParams struct {
+	MaxEvidenceAge          time.Duration `json:"max_evidence_age,string"`
+	SignedBlocksWindow      int64         `json:"signed_blocks_window,string"`
+	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window"`
+	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration,string"`
+	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign"`
+	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime"`
+}
+
+// GetSigningInfo returns the signing info of the validator with the bech32 consensus public key.
+func (hbc *Hbc) GetSigningInfo(consPubKey string) (*ValidatorSigningInfo, error) {
+	var info ValidatorSigningInfo
+	err := hbc.queryResult("/slashing/validators/"+consPubKey+"/signing_info", map[string]interface {
+	}{}, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// GetValidatorSigningInfo returns the signing info of validator.
+func (hbc *Hbc) GetValidatorSigningInfo(validator Validator) (*ValidatorSigningInfo, error) {
+	return hbc.GetSigningInfo(validator.ConsPubKey)
+}
+
+func (hbc *Hbc) GetSigningInfos() ([]ValidatorSigningInfo, error) {
+	var infos []ValidatorSigningInfo
+	err := hbc.queryResult("/slashing/signing_infos", map[string]interface {
+	}{}, &infos)
+	if err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
+
+func (hbc *Hbc) GetSlashingParams() (*SlashingParams, error) {
+	var params SlashingParams
+	err := hbc.queryResult("/slashing/parameters", map[string]interface {
+	}{}, &params)
+	if err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
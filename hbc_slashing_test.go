@@ -0,0 +1,49 @@
+package hbc
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+func TestSlashingQueries(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	consPubKey, err := utils.Bech32ifyPubKey(utils.Bech32PrefixConsPub, pubKey)
+	require.Nil(t, err)
+	consAddr := utils.GetConsAddress(pubKey)
+
+	client, closer := newTestLCD(t, map[string]string{
+		"/slashing/validators/" + consPubKey + "/signing_info": `{"height":"20","result":{"address":"` + consAddr.String() + `","start_height":"3","index_offset":"80","jailed_until":"2030-01-01T00:00:00Z","tombstoned":false,"missed_blocks_counter":"25"}}`,
+		"/slashing/parameters":                                 `{"height":"20","result":{"max_evidence_age":"120000000000","signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600000000000","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"}}`,
+	})
+	defer closer()
+
+	info, err := client.GetValidatorSigningInfo(Validator{ConsPubKey: consPubKey})
+	require.Nil(t, err)
+	require.Equal(t, consAddr, info.Address)
+	require.Equal(t, int64(25), info.MissedBlocksCounter)
+	require.True(t, info.IsJailed(time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.False(t, info.Tombstoned)
+
+	params, err := client.GetSlashingParams()
+	require.Nil(t, err)
+	require.Equal(t, int64(100), params.SignedBlocksWindow)
+	require.Equal(t, 10*time.Minute, params.DowntimeJailDuration)
+
+	uptime, err := info.Uptime(*params)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), uptime)
+	require.True(t, uptime.GTE(params.MinSignedPerWindow))
+}
+
+func TestMsgUnjail(t *testing.T) {
+	valAddr := utils.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	msg := utils.NewMsgUnjail(valAddr)
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []utils.CUAddress{utils.CUAddress(valAddr)}, msg.GetSigners())
+	require.NotNil(t, utils.NewMsgUnjail(nil).ValidateBasic())
+}
@@ -0,0 +1,252 @@
+package hbc
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+type ValidatorStatus int
+
+const (
+	ValidatorUnbonded  ValidatorStatus = 0x00
+	ValidatorUnbonding ValidatorStatus = 0x01
+	ValidatorBonded    ValidatorStatus = 0x02
+)
+
+// status filters of the validators query
+const (
+	ValidatorStatusBonded    = "bonded"
+	ValidatorStatusUnbonding = "unbonding"
+	ValidatorStatusUnbonded  = "unbonded"
+)
+
+type Description = utils.Description
+
+type CommissionRates = utils.CommissionRates
+
+type Commission struct {
+	CommissionRates `json:"commission_rates"`
+	UpdateTime      time.Time `json:"update_time"`
+}
+
+type Validator struct {
+	OperatorAddress         utils.ValAddress `json:"operator_address"`
+	ConsPubKey              string           `json:"consensus_pubkey"`
+	Jailed                  bool             `json:"jailed"`
+	Status                  ValidatorStatus  `json:"status"`
+	Tokens                  sdk.Int          `json:"tokens"`
+	DelegatorShares         sdk.Dec          `json:"delegator_shares"`
+	Description             Description      `json:"description"`
+	UnbondingHeight         int64            `json:"unbonding_height,string"`
+	UnbondingCompletionTime time.Time        `json:"unbonding_time"`
+	Commission              Commission       `json:"commission"`
+	MinSelfDelegation       sdk.Int          `json:"min_self_delegation"`
+}
+
+// GetConsPubKey decodes the bech32 consensus public key of the validator.
+func (v Validator) GetConsPubKey() (crypto.PubKey, error) {
+	return utils.GetPubKeyFromBech32(utils.Bech32PrefixConsPub, v.ConsPubKey)
+}
+
+// GetConsAddress returns the consensus address of the validator.
+func (v Validator) GetConsAddress() (utils.ConsAddress, error) {
+	pubKey, err := v.GetConsPubKey()
+	if err != nil {
+		return nil, err
+	}
+	return utils.GetConsAddress(pubKey), nil
+}
+
+// IsBonded checks if the validator is in the active set.
+func (v Validator) IsBonded() bool {
+	return v.Status == ValidatorBonded
+}
+
+type DelegationResponse struct {
+	DelegatorAddress utils.CUAddress  `json:"delegator_address"`
+	ValidatorAddress utils.ValAddress `json:"validator_address"`
+	Shares           sdk.Dec          `json:"shares"`
+	Balance          utils.Coin       `json:"balance"`
+}
+
+type UnbondingDelegationEntry struct {
+	CreationHeight int64     `json:"creation_height,string"`
+	CompletionTime time.Time `json:"completion_time"`
+	InitialBalance sdk.Int   `json:"initial_balance"`
+	Balance        sdk.Int   `json:"balance"`
+}
+
+type UnbondingDelegation struct {
+	DelegatorAddress utils.CUAddress            `json:"delegator_address"`
+	ValidatorAddress utils.ValAddress           `json:"validator_address"`
+	Entries          []UnbondingDelegationEntry `json:"entries"`
+}
+
+type RedelegationEntryResponse struct {
+	CreationHeight int64     `json:"creation_height,string"`
+	CompletionTime time.Time `json:"completion_time"`
+	InitialBalance sdk.Int   `json:"initial_balance"`
+	SharesDst      sdk.Dec   `json:"shares_dst"`
+	Balance        sdk.Int   `json:"balance"`
+}
+
+type RedelegationResponse struct {
+	DelegatorAddress    utils.CUAddress             `json:"delegator_address"`
+	ValidatorSrcAddress utils.ValAddress            `json:"validator_src_address"`
+	ValidatorDstAddress utils.ValAddress            `json:"validator_dst_address"`
+	Entries             []RedelegationEntryResponse `json:"entries"`
+}
+
+type StakingPool struct {
+	NotBondedTokens sdk.Int `json:"not_bonded_tokens"`
+	BondedTokens    sdk.Int `json:"bonded_tokens"`
+}
+
+type StakingParams struct {
+	UnbondingTime time.Duration `json:"unbonding_time,string"`
+	MaxValidators uint16        `json:"max_validators"`
+	MaxEntries    uint16        `json:"max_entries"`
+	BondDenom     string        `json:"bond_denom"`
+}
+
+// GetValidators returns the validators with status, one of bonded, unbonding and unbonded.
+// page and limit are ignored when 0.
+func (hbc *Hbc) GetValidators(status string, page, limit int) ([]Validator, error) {
+	args := map[string]interface{}{}
+	if status != "" {
+		args["status"] = status
+	}
+	if page > 0 {
+		args["page"] = strconv.Itoa(page)
+	}
+	if limit > 0 {
+		args["limit"] = strconv.Itoa(limit)
+	}
+
+	var validators []Validator
+	err := hbc.queryResult("/staking/validators", args, &validators)
+	if err != nil {
+		return nil, err
+	}
+	return validators, nil
+}
+
+func (hbc *Hbc) GetValidator(validatorAddr string) (*Validator, error) {
+	var validator Validator
+	err := hbc.queryResult("/staking/validators/"+validatorAddr, map[string]interface {
+	}{}, &validator)
+	if err != nil {
+		return nil, err
+	}
+	return &validator, nil
+}
+
+// GetValidatorByConsAddress returns the validator owning the consensus address.
+func (hbc *Hbc) GetValidatorByConsAddress(consAddr utils.ConsAddress) (*Validator, error) {
+	for _, status := range []string{ValidatorStatusBonded, ValidatorStatusUnbonding, ValidatorStatusUnbonded} {
+		validators, err := hbc.GetValidators(status, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, validator := range validators {
+			addr, err := validator.GetConsAddress()
+			if err != nil {
+				return nil, err
+			}
+			if addr.Equals(consAddr) {
+				return &validator, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no validator with consensus address %v", consAddr)
+}
+
+// GetBlockProposerValidator returns the validator which proposed the block at height.
+func (hbc *Hbc) GetBlockProposerValidator(height int64) (*Validator, error) {
+	blockData, err := hbc.GetBlockData(height)
+	if err != nil {
+		return nil, err
+	}
+	proposer, err := blockData.ProposerConsAddress()
+	if err != nil {
+		return nil, err
+	}
+	return hbc.GetValidatorByConsAddress(proposer)
+}
+
+func (hbc *Hbc) GetDelegations(delegatorAddr string) ([]DelegationResponse, error) {
+	var delegations []DelegationResponse
+	err := hbc.queryResult("/staking/delegators/"+delegatorAddr+"/delegations", map[string]interface {
+	}{}, &delegations)
+	if err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+func (hbc *Hbc) GetDelegation(delegatorAddr, validatorAddr string) (*DelegationResponse, error) {
+	var delegation DelegationResponse
+	err := hbc.queryResult("/staking/delegators/"+delegatorAddr+"/delegations/"+validatorAddr, map[string]interface {
+	}{}, &delegation)
+	if err != nil {
+		return nil, err
+	}
+	return &delegation, nil
+}
+
+func (hbc *Hbc) GetUnbondingDelegations(delegatorAddr string) ([]UnbondingDelegation, error) {
+	var ubds []UnbondingDelegation
+	err := hbc.queryResult("/staking/delegators/"+delegatorAddr+"/unbonding_delegations", map[string]interface {
+	}{}, &ubds)
+	if err != nil {
+		return nil, err
+	}
+	return ubds, nil
+}
+
+// GetRedelegations returns the redelegations matching the non empty filters.
+func (hbc *Hbc) GetRedelegations(delegatorAddr, srcValidatorAddr, dstValidatorAddr string) ([]RedelegationResponse, error) {
+	args := map[string]interface{}{}
+	if delegatorAddr != "" {
+		args["delegator"] = delegatorAddr
+	}
+	if srcValidatorAddr != "" {
+		args["validator_from"] = srcValidatorAddr
+	}
+	if dstValidatorAddr != "" {
+		args["validator_to"] = dstValidatorAddr
+	}
+
+	var reds []RedelegationResponse
+	err := hbc.queryResult("/staking/redelegations", args, &reds)
+	if err != nil {
+		return nil, err
+	}
+	return reds, nil
+}
+
+func (hbc *Hbc) GetStakingPool() (*StakingPool, error) {
+	var pool StakingPool
+	err := hbc.queryResult("/staking/pool", map[string]interface {
+	}{}, &pool)
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+func (hbc *Hbc) GetStakingParams() (*StakingParams, error) {
+	var params StakingParams
+	err := hbc.queryResult("/staking/parameters", map[string]interface {
+	}{}, &params)
+	if err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
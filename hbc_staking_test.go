@@ -0,0 +1,106 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func TestStakingQueries(t *testing.T) {
+	consPub := ed25519.GenPrivKeyFromSecret([]byte("validator")).PubKey()
+	consPubStr, err := utils.Bech32ifyPubKey(utils.Bech32PrefixConsPub, consPub)
+	require.Nil(t, err)
+	valAddr := utils.ValAddress(utils.NewCUAddress())
+	delAddr := utils.NewCUAddress()
+
+	validator := fmt.Sprintf(`{"operator_address":"%s","consensus_pubkey":"%s","jailed":false,"status":2,"tokens":"1000000","delegator_shares":"1000000.000000000000000000","description":{"moniker":"hbc-1","identity":"","website":"","security_contact":"","details":""},"unbonding_height":"0","unbonding_time":"1970-01-01T00:00:00Z","commission":{"commission_rates":{"rate":"0.100000000000000000","max_rate":"0.200000000000000000","max_change_rate":"0.010000000000000000"},"update_time":"2021-03-01T00:00:00Z"},"min_self_delegation":"1"}`, valAddr, consPubStr)
+	client, closer := newTestLCD(t, map[string]string{
+		"/staking/validators":                     `{"height":"10","result":[` + validator + `]}`,
+		"/staking/validators/" + valAddr.String(): `{"height":"10","result":` + validator + `}`,
+		"/blocks/10":                              fmt.Sprintf(`{"block":{"header":{"height":"10","proposer_address":"%s"}}}`, strings.ToUpper(hex.EncodeToString(consPub.Address()))),
+		"/staking/delegators/" + delAddr.String() + "/delegations":           fmt.Sprintf(`{"height":"10","result":[{"delegator_address":"%s","validator_address":"%s","shares":"100.000000000000000000","balance":{"denom":"hbc","amount":"100"}}]}`, delAddr, valAddr),
+		"/staking/delegators/" + delAddr.String() + "/unbonding_delegations": fmt.Sprintf(`{"height":"10","result":[{"delegator_address":"%s","validator_address":"%s","entries":[{"creation_height":"8","completion_time":"2021-03-22T00:00:00Z","initial_balance":"50","balance":"50"}]}]}`, delAddr, valAddr),
+		"/staking/redelegations": `{"height":"10","result":[]}`,
+		"/staking/pool":          `{"height":"10","result":{"not_bonded_tokens":"5","bonded_tokens":"1000000"}}`,
+		"/staking/parameters":    `{"height":"10","result":{"unbonding_time":"1814400000000000","max_validators":100,"max_entries":7,"bond_denom":"hbc"}}`,
+	})
+	defer closer()
+
+	validators, err := client.GetValidators(ValidatorStatusBonded, 1, 10)
+	require.Nil(t, err)
+	require.Len(t, validators, 1)
+	require.Equal(t, valAddr, validators[0].OperatorAddress)
+	require.True(t, validators[0].IsBonded())
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), validators[0].Commission.Rate)
+
+	v, err := client.GetValidator(valAddr.String())
+	require.Nil(t, err)
+	require.Equal(t, "hbc-1", v.Description.Moniker)
+
+	proposer, err := client.GetBlockProposerValidator(10)
+	require.Nil(t, err)
+	require.Equal(t, valAddr, proposer.OperatorAddress)
+
+	delegations, err := client.GetDelegations(delAddr.String())
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(100), delegations[0].Balance.Amount)
+
+	ubds, err := client.GetUnbondingDelegations(delAddr.String())
+	require.Nil(t, err)
+	require.Equal(t, int64(8), ubds[0].Entries[0].CreationHeight)
+
+	reds, err := client.GetRedelegations(delAddr.String(), "", "")
+	require.Nil(t, err)
+	require.Len(t, reds, 0)
+
+	pool, err := client.GetStakingPool()
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(1000000), pool.BondedTokens)
+
+	params, err := client.GetStakingParams()
+	require.Nil(t, err)
+	require.Equal(t, 21*24*time.Hour, params.UnbondingTime)
+	require.Equal(t, "hbc", params.BondDenom)
+}
+
+func TestStakingMsgs(t *testing.T) {
+	prikeyByte, _ := hex.DecodeString("da0bbe0acb8aae423de68a1a59379512d9d92ed453743592d6c3b2bc04252640")
+	delAddr := utils.CUAddressFromPubKey(SecpPrivKeyGen(prikeyByte).PubKey())
+	valSrc := utils.ValAddress(utils.NewCUAddress())
+	valDst := utils.ValAddress(utils.NewCUAddress())
+	amount := utils.NewInt64Coin("hbc", 100)
+
+	msgs := []utils.Msg{
+		utils.NewMsgDelegate(delAddr, valSrc, amount),
+		utils.NewMsgUndelegate(delAddr, valSrc, amount),
+		utils.NewMsgBeginRedelegate(delAddr, valSrc, valDst, amount),
+	}
+	for _, msg := range msgs {
+		require.Nil(t, msg.ValidateBasic())
+	}
+	require.NotNil(t, utils.NewMsgBeginRedelegate(delAddr, valSrc, valSrc, amount).ValidateBasic())
+	require.NotNil(t, utils.NewMsgDelegate(delAddr, valSrc, utils.NewInt64Coin("hbc", 0)).ValidateBasic())
+
+	// missing amounts are rejected, not dereferenced
+	missing := utils.Coin{Denom: "hbc"}
+	require.False(t, missing.IsValid())
+	require.NotNil(t, utils.NewMsgDelegate(delAddr, valSrc, missing).ValidateBasic())
+	require.NotNil(t, utils.NewMsgUndelegate(delAddr, valSrc, missing).ValidateBasic())
+	require.NotNil(t, utils.NewMsgBeginRedelegate(delAddr, valSrc, valDst, missing).ValidateBasic())
+
+	txData, err := CreateMsgsTransaction(prikeyByte, msgs, "", DefaultFee, 1)
+	require.Nil(t, err)
+
+	var sendData tx.SendData
+	require.Nil(t, tx.Cdc.UnmarshalJSON(txData, &sendData))
+	require.Equal(t, msgs, sendData.Tx.Msgs)
+	require.Contains(t, string(txData), "hbtcchain/staking/MsgBeginRedelegate")
+}
@@ -0,0 +1,174 @@
+package hbc
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+// Pair is the swap pool of two tokens.
+type Pair struct {
+	TokenA         string  `json:"token_a"`
+	TokenB         string  `json:"token_b"`
+	ReserveA       sdk.Int `json:"reserve_a"`
+	ReserveB       sdk.Int `json:"reserve_b"`
+	TotalLiquidity sdk.Int `json:"total_liquidity"`
+}
+
+// LiquidityPosition is the share of an account in a swap pool.
+type LiquidityPosition struct {
+	TokenA    string  `json:"token_a"`
+	TokenB    string  `json:"token_b"`
+	Liquidity sdk.Int `json:"liquidity"`
+}
+
+type SwapParams struct {
+	FeeRate sdk.Dec `json:"fee_rate"`
+}
+
+func (hbc *Hbc) GetPairs() ([]Pair, error) {
+	var pairs []Pair
+	err := hbc.queryResult("/openswap/pairs", map[string]interface {
+	}{}, &pairs)
+	if err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+func (hbc *Hbc) GetPair(tokenA, tokenB string) (*Pair, error) {
+	var pair Pair
+	err := hbc.queryResult("/openswap/pairs/"+tokenA+"/"+tokenB, map[string]interface {
+	}{}, &pair)
+	if err != nil {
+		return nil, err
+	}
+	return &pair, nil
+}
+
+// GetLiquidityPositions returns the swap pool shares of address.
+func (hbc *Hbc) GetLiquidityPositions(address string) ([]LiquidityPosition, error) {
+	var positions []LiquidityPosition
+	err := hbc.queryResult("/openswap/liquidity/"+address, map[string]interface {
+	}{}, &positions)
+	if err != nil {
+		return nil, err
+	}
+	return positions, nil
+}
+
+func (hbc *Hbc) GetSwapParams() (*SwapParams, error) {
+	var params SwapParams
+	err := hbc.queryResult("/openswap/parameters", map[string]interface {
+	}{}, &params)
+	if err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+// reserves returns the reserves of the pair ordered as denomIn, denomOut.
+func (p Pair) reserves(denomIn, denomOut string) (sdk.Int, sdk.Int, error) {
+	if utils.IsNilInt(p.ReserveA) || utils.IsNilInt(p.ReserveB) {
+		return sdk.Int{}, sdk.Int{}, fmt.Errorf("pair %v/%v without reserves", p.TokenA, p.TokenB)
+	}
+	switch {
+	case p.TokenA == denomIn && p.TokenB == denomOut:
+		return p.ReserveA, p.ReserveB, nil
+	case p.TokenB == denomIn && p.TokenA == denomOut:
+		return p.ReserveB, p.ReserveA, nil
+	default:
+		return sdk.Int{}, sdk.Int{}, fmt.Errorf("pair %v/%v cannot swap %v to %v", p.TokenA, p.TokenB, denomIn, denomOut)
+	}
+}
+
+// feeFactors returns 1 - feeRate and 1 as integers of the precision of sdk.Dec.
+func feeFactors(feeRate sdk.Dec) (sdk.Int, sdk.Int, error) {
+	if feeRate.Int == nil {
+		return sdk.Int{}, sdk.Int{}, errors.New("missing fee rate")
+	}
+	if feeRate.IsNegative() || feeRate.GTE(sdk.OneDec()) {
+		return sdk.Int{}, sdk.Int{}, fmt.Errorf("invalid fee rate %v", feeRate)
+	}
+	return sdk.NewIntFromBigInt(sdk.OneDec().Sub(feeRate).Int), sdk.NewIntFromBigInt(sdk.OneDec().Int), nil
+}
+
+// QuoteExactIn returns the amount of denomOut the pool pays for amountIn,
+// reserveOut * amountIn * (1 - feeRate) / (reserveIn + amountIn * (1 - feeRate)) rounded down.
+func (p Pair) QuoteExactIn(amountIn utils.Coin, denomOut string, feeRate sdk.Dec) (utils.Coin, error) {
+	if utils.IsNilInt(amountIn.Amount) || !amountIn.IsPositive() {
+		return utils.Coin{}, errors.New("amount in must be positive")
+	}
+	reserveIn, reserveOut, err := p.reserves(amountIn.Denom, denomOut)
+	if err != nil {
+		return utils.Coin{}, err
+	}
+	if !reserveIn.IsPositive() || !reserveOut.IsPositive() {
+		return utils.Coin{}, errors.New("insufficient liquidity")
+	}
+	feeFactor, one, err := feeFactors(feeRate)
+	if err != nil {
+		return utils.Coin{}, err
+	}
+
+	amountInWithFee := amountIn.Amount.Mul(feeFactor)
+	numerator := amountInWithFee.Mul(reserveOut)
+	denominator := reserveIn.Mul(one).Add(amountInWithFee)
+	return utils.NewCoin(denomOut, numerator.Quo(denominator)), nil
+}
+
+// QuoteExactOut returns the amount of denomIn the pool takes for amountOut,
+// reserveIn * amountOut / ((reserveOut - amountOut) * (1 - feeRate)) rounded up.
+func (p Pair) QuoteExactOut(amountOut utils.Coin, denomIn string, feeRate sdk.Dec) (utils.Coin, error) {
+	if utils.IsNilInt(amountOut.Amount) || !amountOut.IsPositive() {
+		return utils.Coin{}, errors.New("amount out must be positive")
+	}
+	reserveIn, reserveOut, err := p.reserves(denomIn, amountOut.Denom)
+	if err != nil {
+		return utils.Coin{}, err
+	}
+	if !reserveIn.IsPositive() || amountOut.Amount.GTE(reserveOut) {
+		return utils.Coin{}, errors.New("insufficient liquidity")
+	}
+	feeFactor, one, err := feeFactors(feeRate)
+	if err != nil {
+		return utils.Coin{}, err
+	}
+
+	numerator := reserveIn.Mul(amountOut.Amount).Mul(one)
+	denominator := reserveOut.Sub(amountOut.Amount).Mul(feeFactor)
+	return utils.NewCoin(denomIn, numerator.Quo(denominator).AddRaw(1)), nil
+}
+
+// MinAmountWithSlippage returns amount reduced by slippage, for MinAmountOut of a swap.
+// The slippage must be in [0, 1).
+func MinAmountWithSlippage(amount sdk.Int, slippage sdk.Dec) (sdk.Int, error) {
+	if err := validateSlippage(amount, slippage); err != nil {
+		return sdk.Int{}, err
+	}
+	return sdk.OneDec().Sub(slippage).MulInt(amount).TruncateInt(), nil
+}
+
+// MaxAmountWithSlippage returns amount increased by slippage, for MaxAmountIn of a swap.
+// The slippage must be in [0, 1).
+func MaxAmountWithSlippage(amount sdk.Int, slippage sdk.Dec) (sdk.Int, error) {
+	if err := validateSlippage(amount, slippage); err != nil {
+		return sdk.Int{}, err
+	}
+	return sdk.OneDec().Add(slippage).MulInt(amount).Ceil().TruncateInt(), nil
+}
+
+func validateSlippage(amount sdk.Int, slippage sdk.Dec) error {
+	if utils.IsNilInt(amount) {
+		return errors.New("missing amount")
+	}
+	if slippage.Int == nil {
+		return errors.New("missing slippage")
+	}
+	if slippage.IsNegative() || slippage.GTE(sdk.OneDec()) {
+		return fmt.Errorf("invalid slippage %v, must be in [0, 1)", slippage)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package hbc
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+func TestSwapQueries(t *testing.T) {
+	address := "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU"
+	client, closer := newTestLCD(t, map[string]string{
+		"/openswap/pairs/btc/hbc":        `{"height":"9","result":{"token_a":"btc","token_b":"hbc","reserve_a":"1000","reserve_b":"2000","total_liquidity":"1414"}}`,
+		"/openswap/liquidity/" + address: `{"height":"9","result":[{"token_a":"btc","token_b":"hbc","liquidity":"100"}]}`,
+		"/openswap/parameters":           `{"height":"9","result":{"fee_rate":"0.003000000000000000"}}`,
+	})
+	defer closer()
+
+	pair, err := client.GetPair("btc", "hbc")
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(1414), pair.TotalLiquidity)
+
+	positions, err := client.GetLiquidityPositions(address)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(100), positions[0].Liquidity)
+
+	params, err := client.GetSwapParams()
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(3, 3), params.FeeRate)
+}
+
+func TestPairQuote(t *testing.T) {
+	pair := Pair{TokenA: "btc", TokenB: "hbc", ReserveA: sdk.NewInt(1000), ReserveB: sdk.NewInt(1000)}
+	feeRate := sdk.NewDecWithPrec(3, 3)
+
+	out, err := pair.QuoteExactIn(utils.NewInt64Coin("btc", 100), "hbc", feeRate)
+	require.Nil(t, err)
+	require.Equal(t, utils.NewInt64Coin("hbc", 90), out)
+
+	in, err := pair.QuoteExactOut(utils.NewInt64Coin("btc", 90), "hbc", feeRate)
+	require.Nil(t, err)
+	require.Equal(t, utils.NewInt64Coin("hbc", 100), in)
+
+	out, err = pair.QuoteExactIn(utils.NewInt64Coin("btc", 100), "hbc", sdk.ZeroDec())
+	require.Nil(t, err)
+	require.Equal(t, utils.NewInt64Coin("hbc", 90), out)
+
+	_, err = pair.QuoteExactOut(utils.NewInt64Coin("hbc", 1000), "btc", feeRate)
+	require.NotNil(t, err)
+	_, err = pair.QuoteExactIn(utils.NewInt64Coin("eth", 1), "hbc", feeRate)
+	require.NotNil(t, err)
+
+	_, err = pair.QuoteExactIn(utils.NewInt64Coin("btc", 100), "hbc", sdk.Dec{})
+	require.NotNil(t, err)
+	_, err = pair.QuoteExactOut(utils.NewInt64Coin("btc", 90), "hbc", sdk.Dec{})
+	require.NotNil(t, err)
+	_, err = pair.QuoteExactIn(utils.Coin{Denom: "btc"}, "hbc", feeRate)
+	require.NotNil(t, err)
+	_, err = pair.QuoteExactOut(utils.Coin{Denom: "btc"}, "hbc", feeRate)
+	require.NotNil(t, err)
+	_, err = Pair{TokenA: "btc", TokenB: "hbc"}.QuoteExactIn(utils.NewInt64Coin("btc", 100), "hbc", feeRate)
+	require.NotNil(t, err)
+
+	minOut, err := MinAmountWithSlippage(sdk.NewInt(90), sdk.NewDecWithPrec(1, 2))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(89), minOut)
+	maxIn, err := MaxAmountWithSlippage(sdk.NewInt(100), sdk.NewDecWithPrec(1, 2))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(101), maxIn)
+	minOut, err = MinAmountWithSlippage(sdk.NewInt(90), sdk.ZeroDec())
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(90), minOut)
+
+	for _, slippage := range []sdk.Dec{sdk.NewDecWithPrec(-1, 2), sdk.OneDec(), sdk.NewDec(2), {}} {
+		_, err = MinAmountWithSlippage(sdk.NewInt(90), slippage)
+		require.NotNil(t, err)
+		_, err = MaxAmountWithSlippage(sdk.NewInt(100), slippage)
+		require.NotNil(t, err)
+	}
+	_, err = MinAmountWithSlippage(sdk.Int{}, sdk.NewDecWithPrec(1, 2))
+	require.NotNil(t, err)
+}
+
+func TestSwapMsgs(t *testing.T) {
+	from, err := utils.CUAddressFromBase58("HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU")
+	require.Nil(t, err)
+
+	swap := utils.NewMsgSwapExactIn(from, utils.NewInt64Coin("btc", 100), utils.NewInt64Coin("hbc", 89), 1700000000)
+	require.Nil(t, swap.ValidateBasic())
+	require.Equal(t, "swap_exact_in", swap.Type())
+	require.NotNil(t, utils.NewMsgSwapExactIn(from, utils.NewInt64Coin("btc", 100), utils.NewInt64Coin("btc", 89), 1700000000).ValidateBasic())
+	require.NotNil(t, utils.NewMsgSwapExactOut(from, utils.NewInt64Coin("hbc", 90), utils.NewInt64Coin("btc", 101), 0).ValidateBasic())
+
+	add := utils.NewMsgAddLiquidity(from, utils.NewInt64Coin("btc", 10), utils.NewInt64Coin("hbc", 20), sdk.NewInt(9), sdk.NewInt(30), 1700000000)
+	require.NotNil(t, add.ValidateBasic())
+	add.MinAmountB = sdk.NewInt(19)
+	require.Nil(t, add.ValidateBasic())
+
+	remove := utils.NewMsgRemoveLiquidity(from, "btc", "hbc", sdk.NewInt(100), sdk.ZeroInt(), sdk.ZeroInt(), 1700000000)
+	require.Nil(t, remove.ValidateBasic())
+	require.Contains(t, string(remove.GetSignBytes()), `"type":"hbtcchain/openswap/MsgRemoveLiquidity"`)
+
+	// missing amounts are rejected, not dereferenced
+	missing := utils.Coin{Denom: "btc"}
+	require.NotNil(t, utils.NewMsgSwapExactIn(from, missing, utils.NewInt64Coin("hbc", 89), 1700000000).ValidateBasic())
+	require.NotNil(t, utils.NewMsgSwapExactIn(from, utils.NewInt64Coin("hbc", 89), missing, 1700000000).ValidateBasic())
+	require.NotNil(t, utils.NewMsgSwapExactOut(from, missing, utils.NewInt64Coin("hbc", 89), 1700000000).ValidateBasic())
+	require.NotNil(t, utils.NewMsgSwapExactOut(from, utils.NewInt64Coin("hbc", 89), missing, 1700000000).ValidateBasic())
+	require.NotNil(t, utils.NewMsgAddLiquidity(from, missing, utils.NewInt64Coin("hbc", 20), sdk.NewInt(9), sdk.NewInt(19), 1700000000).ValidateBasic())
+	require.NotNil(t, utils.NewMsgRemoveLiquidity(from, "btc", "hbc", sdk.Int{}, sdk.ZeroInt(), sdk.ZeroInt(), 1700000000).ValidateBasic())
+	require.NotNil(t, utils.NewMsgRemoveLiquidity(from, "btc", "hbc", sdk.NewInt(100), sdk.Int{}, sdk.ZeroInt(), 1700000000).ValidateBasic())
+}
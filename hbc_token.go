@@ -0,0 +1,259 @@
+package hbc
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// TokenInfo is a token of the token module.
+type TokenInfo struct {
+	Symbol              string  `json:"symbol" yaml:"symbol"`
+	Issuer              string  `json:"issuer" yaml:"issuer"`
+	Chain               string  `json:"chain" yaml:"chain"`
+	Type                uint64  `json:"type,string" yaml:"type"`
+	IsSendEnabled       bool    `json:"is_send_enabled" yaml:"is_send_enabled"`
+	IsDepositEnabled    bool    `json:"is_deposit_enabled" yaml:"is_deposit_enabled"`
+	IsWithdrawalEnabled bool    `json:"is_withdrawal_enabled" yaml:"is_withdrawal_enabled"`
+	Decimals            uint64  `json:"decimals,string" yaml:"decimals"`
+	TotalSupply         sdk.Int `json:"total_supply" yaml:"-"`
+}
+
+func (hbc *Hbc) GetTokens() ([]TokenInfo, error) {
+	var tokens []TokenInfo
+	err := hbc.queryResult("/token/tokens", map[string]interface {
+	}{}, &tokens)
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (hbc *Hbc) GetToken(symbol string) (*TokenInfo, error) {
+	var token TokenInfo
+	err := hbc.queryResult("/token/tokens/"+symbol, map[string]interface {
+	}{}, &token)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// CreateIssueTokenTransaction signs a transaction issuing symbol with decimals, crediting the
+// total supply to the issuer.
+func CreateIssueTokenTransaction(fromPriKey []byte, symbol string, decimals uint64, totalSupply, memo, fee string, sequence int64) ([]byte, error) {
+	return DefaultTxBuilder().CreateIssueTokenTransaction(fromPriKey, symbol, decimals, totalSupply, memo, fee, sequence)
+}
+
+func (b *TxBuilder) CreateIssueTokenTransaction(fromPriKey []byte, symbol string, decimals uint64, totalSupply, memo, fee string, sequence int64) ([]byte, error) {
+	fromAddress, _, err := CreateAddress(fromPriKey)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := newIssueTokenMsg(fromAddress, symbol, decimals, totalSupply)
+	if err != nil {
+		return nil, err
+	}
+	return b.CreateMsgsTransaction(fromPriKey, []utils.Msg{msg}, memo, fee, sequence)
+}
+
+// CreateIssueTokenTransactionByName is CreateIssueTokenTransaction signing with the key name of the keyring.
+func CreateIssueTokenTransactionByName(kr Keyring, name, symbol string, decimals uint64, totalSupply, memo, fee string, sequence int64) ([]byte, error) {
+	return DefaultTxBuilder().CreateIssueTokenTransactionByName(kr, name, symbol, decimals, totalSupply, memo, fee, sequence)
+}
+
+func (b *TxBuilder) CreateIssueTokenTransactionByName(kr Keyring, name, symbol string, decimals uint64, totalSupply, memo, fee string, sequence int64) ([]byte, error) {
+	info, err := kr.Show(name)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := newIssueTokenMsg(info.Address, symbol, decimals, totalSupply)
+	if err != nil {
+		return nil, err
+	}
+	return b.CreateMsgsTransactionByName(kr, name, []utils.Msg{msg}, memo, fee, sequence)
+}
+
+func newIssueTokenMsg(fromAddress, symbol string, decimals uint64, totalSupply string) (utils.MsgNewToken, error) {
+	from, err := utils.CUAddressFromBase58(fromAddress)
+	if err != nil {
+		return utils.MsgNewToken{}, err
+	}
+	supply, ok := sdk.NewIntFromString(totalSupply)
+	if !ok {
+		return utils.MsgNewToken{}, fmt.Errorf("error total supply: %v", totalSupply)
+	}
+	return utils.NewMsgNewToken(from, from, symbol, decimals, supply), nil
+}
+
+// TokenOverride replaces the non nil fields of a chain token.
+type TokenOverride struct {
+	Symbol        string  `json:"symbol" yaml:"symbol"`
+	Issuer        *string `json:"issuer,omitempty" yaml:"issuer,omitempty"`
+	Decimals      *uint64 `json:"decimals,omitempty" yaml:"decimals,omitempty"`
+	IsSendEnabled *bool   `json:"is_send_enabled,omitempty" yaml:"is_send_enabled,omitempty"`
+}
+
+type tokenOverrideFile struct {
+	Tokens []TokenOverride `json:"tokens" yaml:"tokens"`
+}
+
+// TokenRegistry resolves token symbols to their decimals, issuer and flags.
+// Static overrides take precedence over the tokens loaded from the chain.
+type TokenRegistry struct {
+	mtx       sync.RWMutex
+	tokens    map[string]TokenInfo
+	overrides map[string]TokenOverride
+}
+
+// NewTokenRegistry returns a registry knowing the fee token with DefaultDecimals.
+func NewTokenRegistry() *TokenRegistry {
+	return NewNetworkTokenRegistry(DefaultNetwork())
+}
+
+// NewNetworkTokenRegistry returns a registry knowing the fee token of network.
+func NewNetworkTokenRegistry(network Network) *TokenRegistry {
+	r := &TokenRegistry{
+		tokens:    map[string]TokenInfo{},
+		overrides: map[string]TokenOverride{},
+	}
+	r.Set(TokenInfo{
+		Symbol:        network.FeeDenom,
+		Decimals:      network.Decimals,
+		IsSendEnabled: true,
+	})
+	return r
+}
+
+// Load adds the tokens of the chain token module.
+func (r *TokenRegistry) Load(hbc *Hbc) error {
+	tokens, err := hbc.GetTokens()
+	if err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		r.Set(token)
+	}
+	return nil
+}
+
+// LoadOverrideFile reads static overrides from a YAML or JSON file of the form
+// tokens: [{symbol: hbc, decimals: 18}]. Nothing is applied unless every entry is valid.
+func (r *TokenRegistry) LoadOverrideFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var file tokenOverrideFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return err
+	}
+
+	for _, override := range file.Tokens {
+		if err := override.Validate(); err != nil {
+			return fmt.Errorf("%v: %v", path, err)
+		}
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for _, override := range file.Tokens {
+		r.overrides[override.Symbol] = override
+	}
+	return nil
+}
+
+func (o TokenOverride) Validate() error {
+	if o.Symbol == "" {
+		return errors.New("token override without symbol")
+	}
+	if err := utils.ValidateDenom(o.Symbol); err != nil {
+		return err
+	}
+	if o.Decimals != nil && *o.Decimals > utils.MaxTokenDecimals {
+		return fmt.Errorf("token %v decimals %v exceeds %v", o.Symbol, *o.Decimals, utils.MaxTokenDecimals)
+	}
+	return nil
+}
+
+func (r *TokenRegistry) Set(token TokenInfo) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.tokens[token.Symbol] = token
+}
+
+// Get returns the token with symbol, overrides applied. A token only known by an override
+// without decimals is unknown.
+func (r *TokenRegistry) Get(symbol string) (TokenInfo, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	token, ok := r.tokens[symbol]
+	override, overridden := r.overrides[symbol]
+	if !ok && (!overridden || override.Decimals == nil) {
+		return TokenInfo{}, false
+	}
+
+	token.Symbol = symbol
+	if override.Issuer != nil {
+		token.Issuer = *override.Issuer
+	}
+	if override.Decimals != nil {
+		token.Decimals = *override.Decimals
+	}
+	if override.IsSendEnabled != nil {
+		token.IsSendEnabled = *override.IsSendEnabled
+	}
+	return token, true
+}
+
+// Decimals returns the decimals of the token with symbol.
+func (r *TokenRegistry) Decimals(symbol string) (uint64, error) {
+	token, ok := r.Get(symbol)
+	if !ok {
+		return 0, fmt.Errorf("unknown token: %v", symbol)
+	}
+	return token.Decimals, nil
+}
+
+// ParseCoin converts a decimal coin expression, i.e. "1.5 hbc", to a coin in base units.
+func (r *TokenRegistry) ParseCoin(coinStr string) (utils.Coin, error) {
+	amount, denom, err := utils.ParseDecimalCoin(coinStr)
+	if err != nil {
+		return utils.Coin{}, err
+	}
+
+	return r.ParseAmount(amount, denom)
+}
+
+// ParseAmount converts a decimal amount of symbol to a coin in base units.
+func (r *TokenRegistry) ParseAmount(amount, symbol string) (utils.Coin, error) {
+	decimals, err := r.Decimals(symbol)
+	if err != nil {
+		return utils.Coin{}, err
+	}
+
+	units, err := utils.ParseDecimalAmount(amount, decimals)
+	if err != nil {
+		return utils.Coin{}, err
+	}
+	return utils.NewCoin(symbol, units), nil
+}
+
+// FormatCoin converts a coin in base units to a decimal coin expression, i.e. "1.5 hbc".
+func (r *TokenRegistry) FormatCoin(coin utils.Coin) (string, error) {
+	decimals, err := r.Decimals(coin.Denom)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.FormatDecimalAmount(coin.Amount, decimals) + " " + coin.Denom, nil
+}
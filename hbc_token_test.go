@@ -0,0 +1,157 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func TestDecimalAmount(t *testing.T) {
+	for _, c := range []struct {
+		amount   string
+		decimals uint64
+		units    string
+	}{
+		{"1.5", 18, "1500000000000000000"},
+		{"0.000000000000000001", 18, "1"},
+		{".25", 2, "25"},
+		{"12", 0, "12"},
+		{"1.50", 1, "15"},
+		{"0", 8, "0"},
+	} {
+		units, err := utils.ParseDecimalAmount(c.amount, c.decimals)
+		require.Nil(t, err, c.amount)
+		require.Equal(t, c.units, units.String())
+	}
+
+	for _, amount := range []string{"1.5.1", "-1", "1e18", "", "0.001"} {
+		_, err := utils.ParseDecimalAmount(amount, 2)
+		require.NotNil(t, err, amount)
+	}
+
+	require.Equal(t, "1.5", utils.FormatDecimalAmount(sdk.NewInt(15), 1))
+	require.Equal(t, "0.000000000000000001", utils.FormatDecimalAmount(sdk.NewInt(1), 18))
+	require.Equal(t, "100", utils.FormatDecimalAmount(sdk.NewInt(10000), 2))
+	require.Equal(t, "-0.05", utils.FormatDecimalAmount(sdk.NewInt(-5), 2))
+	require.Equal(t, "7", utils.FormatDecimalAmount(sdk.NewInt(7), 0))
+	require.Equal(t, "0", utils.FormatDecimalAmount(sdk.Int{}, 18))
+}
+
+func TestTokenRegistry(t *testing.T) {
+	client, closer := newTestLCD(t, map[string]string{
+		"/token/tokens": `{"height":"10","result":[{"symbol":"btc","issuer":"","chain":"btc","type":"1","is_send_enabled":true,"is_deposit_enabled":true,"is_withdrawal_enabled":true,"decimals":"8","total_supply":"2100000000000000"},{"symbol":"kiwi","issuer":"HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU","chain":"hbc","type":"3","is_send_enabled":false,"decimals":"6","total_supply":"1000000"}]}`,
+	})
+	defer closer()
+
+	registry := NewTokenRegistry()
+	require.Nil(t, registry.Load(client))
+
+	coin, err := registry.ParseCoin("1.5 hbc")
+	require.Nil(t, err)
+	require.Equal(t, "1500000000000000000hbc", coin.String())
+
+	coin, err = registry.ParseCoin("0.00000001btc")
+	require.Nil(t, err)
+	require.Equal(t, utils.NewInt64Coin("btc", 1), coin)
+	_, err = registry.ParseCoin("0.000000001 btc")
+	require.NotNil(t, err)
+	_, err = registry.ParseCoin("1 eth")
+	require.NotNil(t, err)
+
+	formatted, err := registry.FormatCoin(utils.NewInt64Coin("kiwi", 2500000))
+	require.Nil(t, err)
+	require.Equal(t, "2.5 kiwi", formatted)
+	formatted, err = registry.FormatCoin(utils.Coin{Denom: "kiwi"})
+	require.Nil(t, err)
+	require.Equal(t, "0 kiwi", formatted)
+
+	dir, err := ioutil.TempDir("", "tokens")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tokens.yaml")
+	require.Nil(t, ioutil.WriteFile(path, []byte("tokens:\n  - symbol: kiwi\n    decimals: 2\n    is_send_enabled: true\n  - symbol: eth\n    decimals: 18\n"), 0600))
+	require.Nil(t, registry.LoadOverrideFile(path))
+
+	kiwi, ok := registry.Get("kiwi")
+	require.True(t, ok)
+	require.Equal(t, uint64(2), kiwi.Decimals)
+	require.True(t, kiwi.IsSendEnabled)
+	require.Equal(t, "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU", kiwi.Issuer)
+
+	coin, err = registry.ParseCoin("1 eth")
+	require.Nil(t, err)
+	require.Equal(t, "1000000000000000000eth", coin.String())
+
+	// an invalid entry rejects the whole file
+	for _, content := range []string{
+		"tokens:\n  - symbol: kiwi\n    decimals: 3\n  - symbol: K!WI\n    decimals: 2\n",
+		"tokens:\n  - symbol: kiwi\n    decimals: 3\n  - symbol: huge\n    decimals: 1000000000\n",
+		"tokens:\n  - symbol: kiwi\n    decimals: 3\n  - decimals: 2\n",
+	} {
+		require.Nil(t, ioutil.WriteFile(path, []byte(content), 0600))
+		require.NotNil(t, registry.LoadOverrideFile(path))
+		kiwi, _ := registry.Get("kiwi")
+		require.Equal(t, uint64(2), kiwi.Decimals)
+	}
+
+	// an override only entry without decimals is no known token
+	require.Nil(t, ioutil.WriteFile(path, []byte("tokens:\n  - symbol: lime\n    is_send_enabled: true\n"), 0600))
+	require.Nil(t, registry.LoadOverrideFile(path))
+	_, ok = registry.Get("lime")
+	require.False(t, ok)
+	_, err = registry.ParseCoin("1 lime")
+	require.NotNil(t, err)
+
+	_, err = utils.ParseDecimalAmount("1", 1000000000)
+	require.NotNil(t, err)
+}
+
+func TestCreateIssueTokenTransaction(t *testing.T) {
+	priKey, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	address, _, err := CreateAddress(priKey)
+	require.Nil(t, err)
+
+	txData, err := CreateIssueTokenTransaction(priKey, "kiwi", 6, "1000000000000", "", DefaultFee, 1)
+	require.Nil(t, err)
+
+	var sendData tx.SendData
+	require.Nil(t, tx.Cdc.UnmarshalJSON(txData, &sendData))
+	msg := sendData.Tx.Msgs[0].(utils.MsgNewToken)
+	require.Equal(t, address, msg.To.String())
+	require.Equal(t, uint64(6), msg.Decimals)
+
+	_, err = CreateIssueTokenTransaction(priKey, "k!wi", 6, "1000", "", DefaultFee, 1)
+	require.NotNil(t, err)
+	_, err = CreateIssueTokenTransaction(priKey, "kiwi", 19, "1000", "", DefaultFee, 1)
+	require.NotNil(t, err)
+
+	from := msg.From
+	require.Nil(t, utils.NewMsgInflateToken(from, from, "kiwi", sdk.NewInt(5)).ValidateBasic())
+	require.NotNil(t, utils.NewMsgBurnToken(from, "kiwi", sdk.ZeroInt()).ValidateBasic())
+	require.NotNil(t, utils.NewMsgBurnToken(from, "kiwi", sdk.Int{}).ValidateBasic())
+	require.NotNil(t, utils.NewMsgInflateToken(from, from, "kiwi", sdk.Int{}).ValidateBasic())
+	require.NotNil(t, utils.NewMsgNewToken(from, from, "kiwi", 8, sdk.Int{}).ValidateBasic())
+	change := utils.NewMsgTokenParamsChange(from, "kiwi", []utils.TokenParamChange{utils.NewTokenParamChange("is_send_enabled", "true")})
+	require.Nil(t, change.ValidateBasic())
+	require.NotNil(t, utils.NewMsgTokenParamsChange(from, "kiwi", nil).ValidateBasic())
+}
+
+func TestGetToken(t *testing.T) {
+	client, closer := newTestLCD(t, map[string]string{
+		"/token/tokens/kiwi": `{"height":"10","result":{"symbol":"kiwi","issuer":"HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU","chain":"hbc","type":"3","is_send_enabled":true,"decimals":"6","total_supply":"1000000000000"}}`,
+	})
+	defer closer()
+
+	token, err := client.GetToken("kiwi")
+	require.Nil(t, err)
+	require.Equal(t, "HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU", token.Issuer)
+	require.Equal(t, uint64(6), token.Decimals)
+	require.Equal(t, sdk.NewInt(1000000000000), token.TotalSupply)
+}
@@ -0,0 +1,73 @@
+package hbc
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func TestCreateValidatorMsg(t *testing.T) {
+	priKey, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	address, _, err := CreateAddress(priKey)
+	require.Nil(t, err)
+	cuAddr, err := utils.CUAddressFromBase58(address)
+	require.Nil(t, err)
+	valAddr := utils.ValAddressFromCUAddress(cuAddr)
+	consPubKey := ed25519.GenPrivKey().PubKey()
+
+	commission := utils.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	description := utils.NewDescription("hbc-validator", "", "https://example.com", "", "")
+	msg := utils.NewMsgCreateValidator(valAddr, consPubKey, utils.NewInt64Coin("hbc", 1000), description, commission, sdk.NewInt(1))
+	require.Nil(t, msg.ValidateBasic())
+
+	bech32ConsPubKey, err := utils.Bech32ifyConsPub(consPubKey)
+	require.Nil(t, err)
+	require.Contains(t, string(msg.GetSignBytes()), `"pubkey":"`+bech32ConsPubKey+`"`)
+
+	txData, err := CreateMsgsTransaction(priKey, []utils.Msg{msg}, "", DefaultFee, 1)
+	require.Nil(t, err)
+	var sendData tx.SendData
+	require.Nil(t, tx.Cdc.UnmarshalJSON(txData, &sendData))
+	decoded := sendData.Tx.Msgs[0].(utils.MsgCreateValidator)
+	require.True(t, consPubKey.Equals(decoded.PubKey))
+	require.Equal(t, commission, decoded.Commission)
+
+	for _, rates := range []utils.CommissionRates{
+		utils.NewCommissionRates(sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+		utils.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(11, 1), sdk.NewDecWithPrec(1, 2)),
+		utils.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(3, 1)),
+		utils.NewCommissionRates(sdk.NewDecWithPrec(-1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+	} {
+		require.NotNil(t, rates.Validate())
+	}
+
+	msg.MinSelfDelegation = sdk.NewInt(2000)
+	require.NotNil(t, msg.ValidateBasic())
+	msg.MinSelfDelegation = sdk.Int{}
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg.MinSelfDelegation = sdk.NewInt(1)
+	msg.Value = utils.Coin{Denom: "hbc"}
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestEditValidatorMsg(t *testing.T) {
+	valAddr := utils.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	description := utils.NewDescription(utils.DoNotModifyDesc, utils.DoNotModifyDesc, "https://example.org", utils.DoNotModifyDesc, utils.DoNotModifyDesc)
+
+	rate := sdk.NewDecWithPrec(15, 2)
+	msg := utils.NewMsgEditValidator(valAddr, description, &rate, nil)
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []utils.CUAddress{utils.CUAddress(valAddr)}, msg.GetSigners())
+
+	rate = sdk.NewDecWithPrec(11, 1)
+	require.NotNil(t, msg.ValidateBasic())
+	require.NotNil(t, utils.NewMsgEditValidator(valAddr, utils.Description{}, nil, nil).ValidateBasic())
+	require.NotNil(t, utils.NewMsgEditValidator(valAddr, description, &sdk.Dec{}, nil).ValidateBasic())
+	require.NotNil(t, utils.NewMsgEditValidator(valAddr, description, nil, &sdk.Int{}).ValidateBasic())
+}
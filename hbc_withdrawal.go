@@ -0,0 +1,193 @@
+package hbc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zxinuoke/hbc-sdk/utils"
+)
+
+type OrderStatus int
+
+const (
+	OrderStatusPending   OrderStatus = 0x1
+	OrderStatusSigning   OrderStatus = 0x2
+	OrderStatusBroadcast OrderStatus = 0x3
+	OrderStatusFinished  OrderStatus = 0x4
+	OrderStatusFailed    OrderStatus = 0x5
+)
+
+func (s OrderStatus) String() string {
+	switch s {
+	case OrderStatusPending:
+		return "pending"
+	case OrderStatusSigning:
+		return "signing"
+	case OrderStatusBroadcast:
+		return "broadcast"
+	case OrderStatusFinished:
+		return "finished"
+	case OrderStatusFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// IsFinal checks if the order will not change anymore.
+func (s OrderStatus) IsFinal() bool {
+	return s == OrderStatusFinished || s == OrderStatusFailed
+}
+
+// WithdrawalOrder is the order created by a MsgWithdrawal.
+type WithdrawalOrder struct {
+	OrderID   string          `json:"order_id"`
+	Symbol    string          `json:"symbol"`
+	CUAddress utils.CUAddress `json:"cu_address"`
+	ToAddress string          `json:"withdraw_to_address"`
+	Amount    sdk.Int         `json:"amount"`
+	GasFee    sdk.Int         `json:"gas_fee"`
+	Status    OrderStatus     `json:"status"`
+	ExtTxHash string          `json:"ext_tx_hash"`
+	Height    int64           `json:"height,string"`
+}
+
+// CreateWithdrawalTransaction signs a transaction withdrawing amount of token to toAddress on the
+// chain of the token, paying gasFee for the external transaction. toAddress is checked for the
+// chain of the token. It returns the transaction and the order id to follow the withdrawal with.
+func CreateWithdrawalTransaction(fromPriKey []byte, token TokenInfo, toAddress string, amount, gasFee, memo, fee string, sequence int64) ([]byte, string, error) {
+	return DefaultTxBuilder().CreateWithdrawalTransaction(fromPriKey, token, toAddress, amount, gasFee, memo, fee, sequence)
+}
+
+func (b *TxBuilder) CreateWithdrawalTransaction(fromPriKey []byte, token TokenInfo, toAddress string, amount, gasFee, memo, fee string, sequence int64) ([]byte, string, error) {
+	fromAddress, _, err := CreateAddress(fromPriKey)
+	if err != nil {
+		return nil, "", err
+	}
+	msg, err := newWithdrawalMsg(b.Network.ExtNetwork, fromAddress, token, toAddress, amount, gasFee)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txData, err := b.CreateMsgsTransaction(fromPriKey, []utils.Msg{msg}, memo, fee, sequence)
+	if err != nil {
+		return nil, "", err
+	}
+	return txData, msg.OrderID, nil
+}
+
+// CreateWithdrawalTransactionByName is CreateWithdrawalTransaction signing with the key name of the keyring.
+func CreateWithdrawalTransactionByName(kr Keyring, name string, token TokenInfo, toAddress string, amount, gasFee, memo, fee string, sequence int64) ([]byte, string, error) {
+	return DefaultTxBuilder().CreateWithdrawalTransactionByName(kr, name, token, toAddress, amount, gasFee, memo, fee, sequence)
+}
+
+func (b *TxBuilder) CreateWithdrawalTransactionByName(kr Keyring, name string, token TokenInfo, toAddress string, amount, gasFee, memo, fee string, sequence int64) ([]byte, string, error) {
+	info, err := kr.Show(name)
+	if err != nil {
+		return nil, "", err
+	}
+	msg, err := newWithdrawalMsg(b.Network.ExtNetwork, info.Address, token, toAddress, amount, gasFee)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txData, err := b.CreateMsgsTransactionByName(kr, name, []utils.Msg{msg}, memo, fee, sequence)
+	if err != nil {
+		return nil, "", err
+	}
+	return txData, msg.OrderID, nil
+}
+
+// CreateWithdrawalTransaction is CreateWithdrawalTransaction of the token with symbol, as read
+// from the chain, for the client network.
+func (hbc *Hbc) CreateWithdrawalTransaction(fromPriKey []byte, symbol, toAddress string, amount, gasFee, memo, fee string, sequence int64) ([]byte, string, error) {
+	token, err := hbc.GetToken(symbol)
+	if err != nil {
+		return nil, "", err
+	}
+	return hbc.TxBuilder().CreateWithdrawalTransaction(fromPriKey, *token, toAddress, amount, gasFee, memo, fee, sequence)
+}
+
+// CreateWithdrawalTransactionByName is CreateWithdrawalTransactionByName of the token with symbol,
+// as read from the chain, for the client network.
+func (hbc *Hbc) CreateWithdrawalTransactionByName(kr Keyring, name, symbol, toAddress string, amount, gasFee, memo, fee string, sequence int64) ([]byte, string, error) {
+	token, err := hbc.GetToken(symbol)
+	if err != nil {
+		return nil, "", err
+	}
+	return hbc.TxBuilder().CreateWithdrawalTransactionByName(kr, name, *token, toAddress, amount, gasFee, memo, fee, sequence)
+}
+
+func newWithdrawalMsg(network utils.ExtNetwork, fromAddress string, token TokenInfo, toAddress, amount, gasFee string) (utils.MsgWithdrawal, error) {
+	from, err := utils.CUAddressFromBase58(fromAddress)
+	if err != nil {
+		return utils.MsgWithdrawal{}, err
+	}
+	if token.Chain == "" {
+		return utils.MsgWithdrawal{}, fmt.Errorf("token %v without chain", token.Symbol)
+	}
+	if !token.IsWithdrawalEnabled {
+		return utils.MsgWithdrawal{}, fmt.Errorf("withdrawal of token %v is disabled", token.Symbol)
+	}
+	if err := utils.ValidateExternalAddress(network, token.Chain, toAddress); err != nil {
+		return utils.MsgWithdrawal{}, err
+	}
+	amountInt, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return utils.MsgWithdrawal{}, fmt.Errorf("error withdrawal amount: %v", amount)
+	}
+	gasFeeInt, ok := sdk.NewIntFromString(gasFee)
+	if !ok {
+		return utils.MsgWithdrawal{}, fmt.Errorf("error withdrawal gas fee: %v", gasFee)
+	}
+	return utils.NewMsgWithdrawal(from, toAddress, token.Symbol, utils.NewOrderID(), amountInt, gasFeeInt), nil
+}
+
+func (hbc *Hbc) GetWithdrawalOrder(orderID string) (*WithdrawalOrder, error) {
+	var order WithdrawalOrder
+	err := hbc.queryResult("/order/orders/"+orderID, map[string]interface {
+	}{}, &order)
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// WaitForWithdrawal polls the order every interval until it is finished or failed or ctx is done,
+// calling onStatus, if not nil, on every status change. A failed order is returned with an error.
+func (hbc *Hbc) WaitForWithdrawal(ctx context.Context, orderID string, interval time.Duration, onStatus func(*WithdrawalOrder)) (*WithdrawalOrder, error) {
+	if err := validatePollInterval(interval); err != nil {
+		return nil, err
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastStatus OrderStatus
+	for {
+		order, err := hbc.GetWithdrawalOrder(orderID)
+		if err != nil {
+			return nil, err
+		}
+		if order.Status != lastStatus {
+			lastStatus = order.Status
+			if onStatus != nil {
+				onStatus(order)
+			}
+		}
+
+		switch order.Status {
+		case OrderStatusFinished:
+			return order, nil
+		case OrderStatusFailed:
+			return order, fmt.Errorf("withdrawal %v failed", orderID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return order, fmt.Errorf("withdrawal %v still %v: %w", orderID, order.Status, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package hbc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zxinuoke/hbc-sdk/utils"
+	"github.com/zxinuoke/hbc-sdk/utils/tx"
+)
+
+func TestValidateExternalAddress(t *testing.T) {
+	main := utils.ExtMainnet
+	require.Nil(t, utils.ValidateExternalAddress(main, "btc", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"))
+	require.Nil(t, utils.ValidateExternalAddress(main, "btc", "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy"))
+	require.Nil(t, utils.ValidateExternalAddress(main, "btc", "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq"))
+	require.NotNil(t, utils.ValidateExternalAddress(main, "btc", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3"))
+	require.NotNil(t, utils.ValidateExternalAddress(main, "btc", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	// testnet3 addresses and public keys are no mainnet withdrawal addresses
+	require.NotNil(t, utils.ValidateExternalAddress(main, "btc", "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn"))
+	require.NotNil(t, utils.ValidateExternalAddress(main, "btc", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"))
+	require.NotNil(t, utils.ValidateExternalAddress(main, "btc", "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"))
+
+	test := utils.ExtTestnet
+	require.Nil(t, utils.ValidateExternalAddress(test, "btc", "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn"))
+	require.Nil(t, utils.ValidateExternalAddress(test, "btc", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"))
+	require.NotNil(t, utils.ValidateExternalAddress(test, "btc", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"))
+	require.NotNil(t, utils.ValidateExternalAddress(test, "btc", "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"))
+
+	require.Nil(t, utils.ValidateExternalAddress(main, "eth", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	require.Nil(t, utils.ValidateExternalAddress(main, "eth", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"))
+	require.NotNil(t, utils.ValidateExternalAddress(main, "eth", "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	require.NotNil(t, utils.ValidateExternalAddress(main, "eth", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea"))
+
+	require.NotNil(t, utils.ValidateExternalAddress(main, "trx", "anything"))
+	require.NotNil(t, utils.ValidateExternalAddress(main, "", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"))
+	require.NotNil(t, utils.ValidateExternalAddress("regtest", "btc", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"))
+}
+
+func TestCreateWithdrawalTransaction(t *testing.T) {
+	priKey, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	usdt := TokenInfo{Symbol: "usdt", Chain: "eth", IsWithdrawalEnabled: true}
+	btc := TokenInfo{Symbol: "btc", Chain: "btc", IsWithdrawalEnabled: true}
+
+	txData, orderID, err := CreateWithdrawalTransaction(priKey, usdt, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "1000000", "21000", "", DefaultFee, 1)
+	require.Nil(t, err)
+
+	var sendData tx.SendData
+	require.Nil(t, tx.Cdc.UnmarshalJSON(txData, &sendData))
+	msg := sendData.Tx.Msgs[0].(utils.MsgWithdrawal)
+	require.Equal(t, orderID, msg.OrderID)
+	require.Equal(t, "usdt", msg.Symbol)
+	require.Equal(t, sdk.NewInt(1000000), msg.Amount)
+	require.Equal(t, sdk.NewInt(21000), msg.GasFee)
+
+	_, _, err = CreateWithdrawalTransaction(priKey, usdt, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", "1000000", "21000", "", DefaultFee, 1)
+	require.NotNil(t, err)
+	_, _, err = CreateWithdrawalTransaction(priKey, usdt, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "1000000", "21000", "", DefaultFee, 1)
+	require.NotNil(t, err)
+	_, _, err = CreateWithdrawalTransaction(priKey, btc, "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn", "0", "100", "", DefaultFee, 1)
+	require.NotNil(t, err)
+	_, _, err = CreateWithdrawalTransaction(priKey, TokenInfo{Symbol: "usdt", IsWithdrawalEnabled: true}, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "1", "1", "", DefaultFee, 1)
+	require.NotNil(t, err)
+
+	// the default network is a testnet, mainnet addresses are rejected
+	_, _, err = CreateWithdrawalTransaction(priKey, btc, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "1", "100", "", DefaultFee, 1)
+	require.NotNil(t, err)
+	_, _, err = NewTxBuilder(MainnetNetwork()).CreateWithdrawalTransaction(priKey, btc, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "1", "100", "", DefaultFee, 1)
+	require.Nil(t, err)
+
+	// missing amounts are rejected, not dereferenced
+	require.NotNil(t, utils.NewMsgWithdrawal(msg.FromCU, msg.ToAddress, "usdt", msg.OrderID, sdk.Int{}, sdk.NewInt(1)).ValidateBasic())
+	require.NotNil(t, utils.NewMsgWithdrawal(msg.FromCU, msg.ToAddress, "usdt", msg.OrderID, sdk.NewInt(1), sdk.Int{}).ValidateBasic())
+}
+
+func TestHbcCreateWithdrawalTransaction(t *testing.T) {
+	priKey, _ := hex.DecodeString("8f6b8d1fa0b0a9d0e4a3e0d0c0b0a09080706050403020100f0e0d0c0b0a0908")
+	client, closer := newTestLCD(t, map[string]string{
+		"/token/tokens/usdt": `{"height":"1","result":{"symbol":"usdt","chain":"eth","type":"2","is_withdrawal_enabled":true,"decimals":"6"}}`,
+	})
+	defer closer()
+
+	_, _, err := client.CreateWithdrawalTransaction(priKey, "usdt", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "1000000", "21000", "", DefaultFee, 1)
+	require.Nil(t, err)
+	_, _, err = client.CreateWithdrawalTransaction(priKey, "usdt", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "1000000", "21000", "", DefaultFee, 1)
+	require.NotNil(t, err)
+	_, _, err = client.CreateWithdrawalTransaction(priKey, "btc", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "1", "1", "", DefaultFee, 1)
+	require.NotNil(t, err)
+}
+
+func TestWaitForWithdrawal(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		status, hash := requests, ""
+		if status >= int(OrderStatusBroadcast) {
+			hash = "0xd5b6ea4d57c5a1a1bce2b9d9a0f5e1c7b2c2b8e0b5f7dfc5b1a2b3c4d5e6f7a8"
+		}
+		if status > int(OrderStatusFinished) {
+			status = int(OrderStatusFinished)
+		}
+		fmt.Fprintf(w, `{"height":"1","result":{"order_id":"o1","symbol":"eth","cu_address":"HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU","withdraw_to_address":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed","amount":"5","gas_fee":"1","status":%d,"ext_tx_hash":"%s","height":"10"}}`, status, hash)
+	}))
+	defer server.Close()
+	client, err := NewHbcClient(server.URL)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var seen []string
+	order, err := client.WaitForWithdrawal(ctx, "o1", time.Millisecond, func(o *WithdrawalOrder) {
+		seen = append(seen, o.Status.String())
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"pending", "signing", "broadcast", "finished"}, seen)
+	require.Equal(t, "0xd5b6ea4d57c5a1a1bce2b9d9a0f5e1c7b2c2b8e0b5f7dfc5b1a2b3c4d5e6f7a8", order.ExtTxHash)
+}
+
+func TestWaitForWithdrawalCancel(t *testing.T) {
+	client, closeLCD := newTestLCD(t, map[string]string{
+		"/order/orders/o2": `{"height":"1","result":{"order_id":"o2","symbol":"eth","cu_address":"HBCb1bg1Y2qxRhVQBUxHE7nWcuKzbM7scrwU","withdraw_to_address":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed","amount":"5","gas_fee":"1","status":1,"ext_tx_hash":"","height":"10"}}`,
+	})
+	defer closeLCD()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	order, err := client.WaitForWithdrawal(ctx, "o2", time.Millisecond, nil)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.Equal(t, OrderStatusPending, order.Status)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = client.WaitForWithdrawal(ctx, "o2", time.Hour, nil)
+	require.True(t, errors.Is(err, context.Canceled))
+
+	_, err = client.WaitForWithdrawal(context.Background(), "o2", -time.Second, nil)
+	require.NotNil(t, err)
+}
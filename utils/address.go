@@ -75,6 +75,19 @@ const (
 	CUTypeORG  CUType = 0x3 //机构地址
 )
 
+func (t CUType) String() string {
+	switch t {
+	case CUTypeUser:
+		return "user"
+	case CUTypeOp:
+		return "op"
+	case CUTypeORG:
+		return "org"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(t))
+	}
+}
+
 // Address is a common interface for different types of addresses used by the SDK
 type Address interface {
 	Equals(Address) bool
@@ -88,8 +101,12 @@ type Address interface {
 
 // Ensure that different address types implement the interface
 var _ Address = CUAddress{}
+var _ Address = ValAddress{}
+var _ Address = ConsAddress{}
 
 var _ yaml.Marshaler = CUAddress{}
+var _ yaml.Marshaler = ValAddress{}
+var _ yaml.Marshaler = ConsAddress{}
 
 // ----------------------------------------------------------------------------
 // CU
@@ -114,16 +131,21 @@ func CUAddressFromHex(address string) (addr CUAddress, err error) {
 	return CUAddress(bz), nil
 }
 
-// CUAddressFromBase58 creates an AccAddress from a base58 string prefixed with "HBT".
+// CUAddressFromBase58 creates an AccAddress from a base58 string prefixed with the configured
+// address prefix, "HBC" by default.
 func CUAddressFromBase58(address string) (addr CUAddress, err error) {
+	return CUAddressFromBase58WithPrefix(address, base58.CurrentAddrPrefix())
+}
+
+// CUAddressFromBase58WithPrefix creates an AccAddress from a base58 string prefixed with prefix.
+func CUAddressFromBase58WithPrefix(address string, prefix base58.AddrPrefix) (addr CUAddress, err error) {
 	// blank input get CUAddress{} without error
 	if len(strings.TrimSpace(address)) == 0 {
 		return CUAddress{}, nil
 	}
 
-	// TODO uncomment
-	if len(strings.TrimSpace(address)) < base58.AddrPrefixLen || address[:base58.AddrPrefixLen] != base58.AddrStrPrefix {
-		return nil, errors.New(fmt.Sprintf("invalid cuaddress:%v with prefixed !=HBC", address))
+	if !strings.HasPrefix(address, prefix.Str) {
+		return nil, errors.New(fmt.Sprintf("invalid cuaddress:%v with prefixed !=%v", address, prefix.Str))
 	}
 
 	bz, version, err := base58.CheckDecode(address)
@@ -132,18 +154,29 @@ func CUAddressFromBase58(address string) (addr CUAddress, err error) {
 		return CUAddress{}, err
 	}
 
-	if len(bz) != (AddrLen + base58.AddrPrefixLen - 1) { //?
+	prefixLen := len(prefix.Bytes)
+	if prefixLen == 0 || len(bz) != (AddrLen+prefixLen-1) {
 		return nil, errors.New("Incorrect address length")
 	}
 
-	prefix := make([]byte, 0, base58.AddrPrefixLen)
-	prefix = append(prefix, version)
-	prefix = append(prefix, bz[:base58.AddrPrefixLen-1]...)
+	bytePrefix := make([]byte, 0, prefixLen)
+	bytePrefix = append(bytePrefix, version)
+	bytePrefix = append(bytePrefix, bz[:prefixLen-1]...)
+
+	if !bytes.Equal(bytePrefix, prefix.Bytes) {
+		return CUAddress{}, fmt.Errorf("string is not prefixed with `%v`", prefix.Str)
+	}
+	return CUAddress(bz[prefixLen-1:]), nil
+}
 
-	if !bytes.Equal(prefix, base58.AddrBytePrefix) {
-		return CUAddress{}, errors.New("string is not prefixed with `HBC`")
+// InitAddrPrefix configures the process wide prefix of CUAddress strings, i.e. "HBC". It must
+// be called before any address is encoded or decoded, see base58.InitAddrPrefix.
+func InitAddrPrefix(prefix string) error {
+	p, err := base58.NewAddrPrefix(prefix)
+	if err != nil {
+		return err
 	}
-	return CUAddress(bz[2:]), nil
+	return base58.InitAddrPrefix(p)
 }
 
 func CUAddressFromPubKey(pubKey crypto.PubKey) CUAddress {
@@ -219,15 +252,15 @@ func (ca CUAddress) Bytes() []byte {
 // String implements the Stringer interface.
 
 func (ca CUAddress) String() string {
+	return ca.StringWithPrefix(base58.CurrentAddrPrefix())
+}
+
+// StringWithPrefix encodes the address with prefix instead of the configured address prefix.
+func (ca CUAddress) StringWithPrefix(prefix base58.AddrPrefix) string {
 	if len(ca) != AddrLen {
 		return ""
 	}
-	b := make([]byte, 0, base58.AddrPrefixLen+len(ca)+4)
-	b = append(b, base58.AddrBytePrefix...) //add 'HBC' prefix
-	b = append(b, ca[:]...)
-	cksum := base58.Checksum(b)
-	b = append(b, cksum[:]...)
-	return base58.Encode(b)
+	return prefix.Encode(ca)
 }
 
 // Format implements the fmt.Formatter interface.
@@ -256,18 +289,13 @@ func NewCUAddress() CUAddress {
 }
 
 func PubkeyToString(pubkey crypto.PubKey) string {
-	return "BHPubKey:" + base58.Encode(pubkey.Bytes())
+	return PubKeyStrPrefix + base58.Encode(pubkey.Bytes())
 }
 
+// IsValidAddr checks the address against the configured address prefix, see ValidateAddr
+// for the reason of an invalid address.
 func IsValidAddr(addr string) bool {
-	if len(addr) <= base58.AddrPrefixLen {
-		return false
-	}
-	if addr[:3] != base58.AddrStrPrefix {
-		return false
-	}
-	_, _, err := base58.CheckDecode(addr)
-	return err == nil
+	return ValidateAddr(addr) == nil
 }
 
 type CUAddressList []CUAddress
@@ -320,3 +348,337 @@ func (ca *CUAddress) UnmarshalYAML(data []byte) error {
 	*ca = ca2
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// validator operator
+// ----------------------------------------------------------------------------
+
+// ValAddress defines a wrapper around bytes meant to present a validator's
+// operator. When marshaled to a string or JSON, it uses Bech32.
+type ValAddress []byte
+
+// ValAddressFromHex creates a ValAddress from a hex string.
+func ValAddressFromHex(address string) (addr ValAddress, err error) {
+	if len(address) == 0 {
+		return addr, errors.New("decoding hex address failed: must provide an address")
+	}
+
+	bz, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return ValAddress(bz), nil
+}
+
+// ValAddressFromBech32 creates a ValAddress from a Bech32 string.
+func ValAddressFromBech32(address string) (addr ValAddress, err error) {
+	if len(strings.TrimSpace(address)) == 0 {
+		return ValAddress{}, nil
+	}
+
+	bz, err := GetFromBech32(address, Bech32PrefixValAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) != AddrLen {
+		return nil, errors.New("Incorrect address length")
+	}
+
+	return ValAddress(bz), nil
+}
+
+// ValAddressFromCUAddress returns the operator address of the CU address.
+func ValAddressFromCUAddress(ca CUAddress) ValAddress {
+	return ValAddress(ca.Bytes())
+}
+
+// ValAddressFromPubKey returns the operator address of the CU public key.
+func ValAddressFromPubKey(pubKey crypto.PubKey) ValAddress {
+	return ValAddress(pubKey.Address().Bytes())
+}
+
+// Returns boolean for whether two ValAddresses are Equal
+func (va ValAddress) Equals(va2 Address) bool {
+	if va.Empty() && va2.Empty() {
+		return true
+	}
+
+	return bytes.Equal(va.Bytes(), va2.Bytes())
+}
+
+// Returns boolean for whether an ValAddress is empty
+func (va ValAddress) Empty() bool {
+	if va == nil {
+		return true
+	}
+
+	va2 := ValAddress{}
+	return bytes.Equal(va.Bytes(), va2.Bytes())
+}
+
+// Marshal returns the raw address bytes. It is needed for protobuf
+// compatibility.
+func (va ValAddress) Marshal() ([]byte, error) {
+	return va, nil
+}
+
+// Unmarshal sets the address to the given data. It is needed for protobuf
+// compatibility.
+func (va *ValAddress) Unmarshal(data []byte) error {
+	*va = data
+	return nil
+}
+
+// MarshalJSON marshals to JSON using Bech32.
+func (va ValAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(va.String())
+}
+
+// MarshalYAML marshals to YAML using Bech32.
+func (va ValAddress) MarshalYAML() (interface{}, error) {
+	return va.String(), nil
+}
+
+// UnmarshalJSON unmarshals from JSON assuming Bech32 encoding.
+func (va *ValAddress) UnmarshalJSON(data []byte) error {
+	var s string
+
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	va2, err := ValAddressFromBech32(s)
+	if err != nil {
+		return err
+	}
+
+	*va = va2
+	return nil
+}
+
+// UnmarshalYAML unmarshals from YAML assuming Bech32 encoding.
+func (va *ValAddress) UnmarshalYAML(data []byte) error {
+	var s string
+
+	err := yaml.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	va2, err := ValAddressFromBech32(s)
+	if err != nil {
+		return err
+	}
+
+	*va = va2
+	return nil
+}
+
+// Bytes returns the raw address bytes.
+func (va ValAddress) Bytes() []byte {
+	return va
+}
+
+// String implements the Stringer interface.
+func (va ValAddress) String() string {
+	if va.Empty() {
+		return ""
+	}
+
+	bech32Addr, err := base58.ConvertAndEncode(Bech32PrefixValAddr, va.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	return bech32Addr
+}
+
+// Format implements the fmt.Formatter interface.
+// nolint: errcheck
+func (va ValAddress) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(va.String()))
+	case 'p':
+		s.Write([]byte(fmt.Sprintf("%p", va)))
+	default:
+		s.Write([]byte(fmt.Sprintf("%X", []byte(va))))
+	}
+}
+
+// ----------------------------------------------------------------------------
+// consensus node
+// ----------------------------------------------------------------------------
+
+// ConsAddress defines a wrapper around bytes meant to present a consensus node.
+// When marshaled to a string or JSON, it uses Bech32.
+type ConsAddress []byte
+
+// ConsAddressFromHex creates a ConsAddress from a hex string, e.g. the
+// proposer address of a block header.
+func ConsAddressFromHex(address string) (addr ConsAddress, err error) {
+	if len(address) == 0 {
+		return addr, errors.New("decoding hex address failed: must provide an address")
+	}
+
+	bz, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return ConsAddress(bz), nil
+}
+
+// ConsAddressFromBech32 creates a ConsAddress from a Bech32 string.
+func ConsAddressFromBech32(address string) (addr ConsAddress, err error) {
+	if len(strings.TrimSpace(address)) == 0 {
+		return ConsAddress{}, nil
+	}
+
+	bz, err := GetFromBech32(address, Bech32PrefixConsAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bz) != AddrLen {
+		return nil, errors.New("Incorrect address length")
+	}
+
+	return ConsAddress(bz), nil
+}
+
+// GetConsAddress returns the consensus address of a consensus public key.
+func GetConsAddress(pubkey crypto.PubKey) ConsAddress {
+	return ConsAddress(pubkey.Address())
+}
+
+// Returns boolean for whether two ConsAddress are Equal
+func (ca ConsAddress) Equals(ca2 Address) bool {
+	if ca.Empty() && ca2.Empty() {
+		return true
+	}
+
+	return bytes.Equal(ca.Bytes(), ca2.Bytes())
+}
+
+// Returns boolean for whether an ConsAddress is empty
+func (ca ConsAddress) Empty() bool {
+	if ca == nil {
+		return true
+	}
+
+	ca2 := ConsAddress{}
+	return bytes.Equal(ca.Bytes(), ca2.Bytes())
+}
+
+// Marshal returns the raw address bytes. It is needed for protobuf
+// compatibility.
+func (ca ConsAddress) Marshal() ([]byte, error) {
+	return ca, nil
+}
+
+// Unmarshal sets the address to the given data. It is needed for protobuf
+// compatibility.
+func (ca *ConsAddress) Unmarshal(data []byte) error {
+	*ca = data
+	return nil
+}
+
+// MarshalJSON marshals to JSON using Bech32.
+func (ca ConsAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ca.String())
+}
+
+// MarshalYAML marshals to YAML using Bech32.
+func (ca ConsAddress) MarshalYAML() (interface{}, error) {
+	return ca.String(), nil
+}
+
+// UnmarshalJSON unmarshals from JSON assuming Bech32 encoding.
+func (ca *ConsAddress) UnmarshalJSON(data []byte) error {
+	var s string
+
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	ca2, err := ConsAddressFromBech32(s)
+	if err != nil {
+		return err
+	}
+
+	*ca = ca2
+	return nil
+}
+
+// UnmarshalYAML unmarshals from YAML assuming Bech32 encoding.
+func (ca *ConsAddress) UnmarshalYAML(data []byte) error {
+	var s string
+
+	err := yaml.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	ca2, err := ConsAddressFromBech32(s)
+	if err != nil {
+		return err
+	}
+
+	*ca = ca2
+	return nil
+}
+
+// Bytes returns the raw address bytes.
+func (ca ConsAddress) Bytes() []byte {
+	return ca
+}
+
+// String implements the Stringer interface.
+func (ca ConsAddress) String() string {
+	if ca.Empty() {
+		return ""
+	}
+
+	bech32Addr, err := base58.ConvertAndEncode(Bech32PrefixConsAddr, ca.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	return bech32Addr
+}
+
+// Format implements the fmt.Formatter interface.
+// nolint: errcheck
+func (ca ConsAddress) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(ca.String()))
+	case 'p':
+		s.Write([]byte(fmt.Sprintf("%p", ca)))
+	default:
+		s.Write([]byte(fmt.Sprintf("%X", []byte(ca))))
+	}
+}
+
+// GetFromBech32 decodes a bytestring from a Bech32 encoded string.
+func GetFromBech32(bech32str, prefix string) ([]byte, error) {
+	if len(bech32str) == 0 {
+		return nil, errors.New("decoding Bech32 address failed: must provide an address")
+	}
+
+	hrp, bz, err := base58.DecodeAndConvert(bech32str)
+	if err != nil {
+		return nil, err
+	}
+
+	if hrp != prefix {
+		return nil, fmt.Errorf("invalid Bech32 prefix; expected %s, got %s", prefix, hrp)
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/zxinuoke/hbc-sdk/utils/base58"
+)
+
+// AddrErrorKind tells why an address is invalid.
+type AddrErrorKind int
+
+const (
+	AddrErrPrefix    AddrErrorKind = 0x1
+	AddrErrCharacter AddrErrorKind = 0x2
+	AddrErrLength    AddrErrorKind = 0x3
+	AddrErrChecksum  AddrErrorKind = 0x4
+)
+
+func (k AddrErrorKind) String() string {
+	switch k {
+	case AddrErrPrefix:
+		return "prefix"
+	case AddrErrCharacter:
+		return "character"
+	case AddrErrLength:
+		return "length"
+	case AddrErrChecksum:
+		return "checksum"
+	default:
+		return fmt.Sprintf("AddrErrorKind(%d)", int(k))
+	}
+}
+
+// AddrError is the reason of an invalid address.
+type AddrError struct {
+	Address string
+	Kind    AddrErrorKind
+	Prefix  string
+	// Position is the byte index of the bad character, set with AddrErrCharacter.
+	Position int
+	Char     rune
+	// Length is the decoded byte length against the expected one, set with AddrErrLength.
+	Length         int
+	ExpectedLength int
+	// Suggestions are the valid addresses one substituted or transposed character away, set with AddrErrChecksum.
+	Suggestions []string
+}
+
+func (e *AddrError) Error() string {
+	switch e.Kind {
+	case AddrErrPrefix:
+		return fmt.Sprintf("invalid address %v: not prefixed with %v", e.Address, e.Prefix)
+	case AddrErrCharacter:
+		return fmt.Sprintf("invalid address %v: invalid base58 character %q at position %d", e.Address, e.Char, e.Position)
+	case AddrErrLength:
+		return fmt.Sprintf("invalid address %v: decoded length %d, expected %d", e.Address, e.Length, e.ExpectedLength)
+	case AddrErrChecksum:
+		if len(e.Suggestions) > 0 {
+			return fmt.Sprintf("invalid address %v: checksum mismatch, did you mean %v", e.Address, strings.Join(e.Suggestions, " or "))
+		}
+		return fmt.Sprintf("invalid address %v: checksum mismatch", e.Address)
+	default:
+		return fmt.Sprintf("invalid address %v", e.Address)
+	}
+}
+
+// ValidateAddr checks the address against the configured address prefix and returns an
+// *AddrError telling why it is invalid.
+func ValidateAddr(address string) error {
+	return ValidateAddrWithPrefix(address, base58.CurrentAddrPrefix())
+}
+
+// ValidateAddrWithPrefix is ValidateAddr against prefix. On a checksum mismatch it suggests
+// the addresses with a valid checksum one character substitution or adjacent transposition away.
+func ValidateAddrWithPrefix(address string, prefix base58.AddrPrefix) error {
+	addrErr := &AddrError{Address: address, Prefix: prefix.Str}
+
+	if prefix.Str == "" || !strings.HasPrefix(address, prefix.Str) {
+		addrErr.Kind = AddrErrPrefix
+		return addrErr
+	}
+
+	for i, c := range address {
+		if c >= 0x80 || strings.IndexByte(base58.Alphabet, byte(c)) < 0 {
+			addrErr.Kind, addrErr.Position, addrErr.Char = AddrErrCharacter, i, c
+			return addrErr
+		}
+	}
+
+	decoded := base58.Decode(address)
+	expectedLen := len(prefix.Bytes) + AddrLen + 4
+	if len(decoded) != expectedLen {
+		addrErr.Kind, addrErr.Length, addrErr.ExpectedLength = AddrErrLength, len(decoded), expectedLen
+		return addrErr
+	}
+	if !bytes.Equal(decoded[:len(prefix.Bytes)], prefix.Bytes) {
+		addrErr.Kind = AddrErrPrefix
+		return addrErr
+	}
+
+	if !checkAddrChecksum(decoded) {
+		addrErr.Kind = AddrErrChecksum
+		addrErr.Suggestions = suggestAddrs(address, prefix)
+		return addrErr
+	}
+	return nil
+}
+
+func checkAddrChecksum(decoded []byte) bool {
+	cksum := base58.Checksum(decoded[:len(decoded)-4])
+	return bytes.Equal(cksum[:], decoded[len(decoded)-4:])
+}
+
+// suggestAddrs searches the single character substitutions and adjacent transpositions
+// after the prefix for valid addresses.
+func suggestAddrs(address string, prefix base58.AddrPrefix) []string {
+	var suggestions []string
+	seen := map[string]bool{address: true}
+	try := func(candidate string) {
+		if seen[candidate] {
+			return
+		}
+		seen[candidate] = true
+
+		decoded := base58.Decode(candidate)
+		if len(decoded) == len(prefix.Bytes)+AddrLen+4 &&
+			bytes.Equal(decoded[:len(prefix.Bytes)], prefix.Bytes) &&
+			checkAddrChecksum(decoded) {
+			suggestions = append(suggestions, candidate)
+		}
+	}
+
+	b := []byte(address)
+	for i := len(prefix.Str); i < len(b); i++ {
+		orig := b[i]
+		for j := 0; j < len(base58.Alphabet); j++ {
+			b[i] = base58.Alphabet[j]
+			try(string(b))
+		}
+		b[i] = orig
+	}
+	for i := len(prefix.Str); i < len(b)-1; i++ {
+		b[i], b[i+1] = b[i+1], b[i]
+		try(string(b))
+		b[i], b[i+1] = b[i+1], b[i]
+	}
+	return suggestions
+}
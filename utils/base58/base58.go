@@ -20,6 +20,9 @@ var (
 	indexZero = int64(0)
 )
 
+// Alphabet is the modified base58 alphabet used by Bitcoin.
+const Alphabet = alphabet
+
 // Decode decodes a modified base58 string to a byte slice.
 func Decode(b string) []byte {
 	answer := big.NewInt(0)
@@ -8,6 +8,9 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"errors"
+	"fmt"
+	"strings"
+	"sync"
 )
 
 // ErrChecksum indicates that the Checksum of a check-encoded string does not verify against
@@ -56,29 +59,100 @@ func CheckDecode(input string) (result []byte, version byte, err error) {
 	return
 }
 
-// String implements the Stringer interface.
+// AddrBytePrefix, AddrStrPrefix and AddrPrefixLen describe the default 'HBC' address prefix.
 var AddrBytePrefix = []byte{2, 16, 66}
 var AddrStrPrefix = "HBC"
 var AddrPrefixLen = len(AddrBytePrefix)
 
-// EthAddrToHBCAddr prepends 'HBC' and appends a four byte Checksum.
-func EthAddrToHBCAddr(input []byte) string {
-	b := make([]byte, 0, AddrPrefixLen+len(input)+4)
-	b = append(b, AddrBytePrefix...) //add 'HBC' prefix
+// AddrPayloadLen is the number of bytes following the prefix bytes of an address: 20 bytes of
+// address and 4 bytes of Checksum.
+const AddrPayloadLen = 20 + 4
+
+// AddrPrefix is a string prefix of base58 addresses and the bytes prepended to the address
+// to make its encoding start with it.
+type AddrPrefix struct {
+	Str   string
+	Bytes []byte
+}
+
+// DefaultAddrPrefix is the 'HBC' prefix.
+var DefaultAddrPrefix = AddrPrefix{Str: AddrStrPrefix, Bytes: AddrBytePrefix}
+
+// NewAddrPrefix derives the prefix bytes making every encoded address start with prefix.
+func NewAddrPrefix(prefix string) (AddrPrefix, error) {
+	if prefix == "" {
+		return AddrPrefix{}, errors.New("empty address prefix")
+	}
+	for i := 0; i < len(prefix); i++ {
+		if b58[prefix[i]] == 255 {
+			return AddrPrefix{}, fmt.Errorf("invalid base58 character %q in address prefix %v", prefix[i], prefix)
+		}
+	}
+
+	p := AddrPrefix{Str: prefix, Bytes: PrependedBytes(prefix, AddrPayloadLen)}
+
+	// round trip the lowest, the highest and a real address
+	low, high := make([]byte, AddrPayloadLen-4), make([]byte, AddrPayloadLen-4)
+	for i := range high {
+		high[i] = 0xff
+	}
+	sample := sha256.Sum256([]byte(prefix))
+	for _, payload := range [][]byte{low, high, sample[:AddrPayloadLen-4]} {
+		encoded := p.Encode(payload)
+		decoded, err := p.Decode(encoded)
+		if err != nil || !bytes.Equal(decoded, payload) {
+			return AddrPrefix{}, fmt.Errorf("no prefix bytes for address prefix %v", prefix)
+		}
+	}
+	return p, nil
+}
+
+// Equal checks if both prefixes encode addresses the same.
+func (p AddrPrefix) Equal(p2 AddrPrefix) bool {
+	return p.Str == p2.Str && bytes.Equal(p.Bytes, p2.Bytes)
+}
+
+var (
+	addrPrefixOnce sync.Once
+	addrPrefix     = DefaultAddrPrefix
+)
+
+// InitAddrPrefix configures the process wide prefix addresses are encoded with in JSON and sign
+// bytes, 'HBC' by default. It fails once any address has been encoded or decoded.
+func InitAddrPrefix(p AddrPrefix) error {
+	set := false
+	addrPrefixOnce.Do(func() {
+		addrPrefix, set = p, true
+	})
+	if !set {
+		return errors.New("address prefix is already in use")
+	}
+	return nil
+}
+
+// CurrentAddrPrefix returns the process wide address prefix, see InitAddrPrefix.
+func CurrentAddrPrefix() AddrPrefix {
+	addrPrefixOnce.Do(func() {})
+	return addrPrefix
+}
+
+// Encode prepends the prefix bytes and appends a four byte Checksum.
+func (p AddrPrefix) Encode(input []byte) string {
+	b := make([]byte, 0, len(p.Bytes)+len(input)+4)
+	b = append(b, p.Bytes...)
 	b = append(b, input[:]...)
 	cksum := Checksum(b)
 	b = append(b, cksum[:]...)
 	return Encode(b)
 }
 
-// HBCAddrToEthAddr decodes a BH Address that was encoded with EthAddrToHBCAddr and verifies the Checksum.
-func HBCAddrToEthAddr(input string) (result []byte, err error) {
-	decoded := Decode(input)
-	if len(decoded) < AddrPrefixLen+4 {
+// Decode decodes a string that was encoded with Encode and verifies the prefix and the Checksum.
+func (p AddrPrefix) Decode(input string) (result []byte, err error) {
+	if !strings.HasPrefix(input, p.Str) {
 		return nil, ErrInvalidFormat
 	}
-	prefix := []byte(input)[0:AddrPrefixLen]
-	if !bytes.Equal(prefix, []byte(AddrStrPrefix)) {
+	decoded := Decode(input)
+	if len(decoded) < len(p.Bytes)+4 || !bytes.Equal(decoded[:len(p.Bytes)], p.Bytes) {
 		return nil, ErrInvalidFormat
 	}
 	var cksum [4]byte
@@ -86,7 +160,17 @@ func HBCAddrToEthAddr(input string) (result []byte, err error) {
 	if Checksum(decoded[:len(decoded)-4]) != cksum {
 		return nil, ErrChecksum
 	}
-	payload := decoded[AddrPrefixLen : len(decoded)-4]
+	payload := decoded[len(p.Bytes) : len(decoded)-4]
 	result = append(result, payload...)
 	return result, nil
 }
+
+// EthAddrToHBCAddr prepends the configured prefix and appends a four byte Checksum.
+func EthAddrToHBCAddr(input []byte) string {
+	return CurrentAddrPrefix().Encode(input)
+}
+
+// HBCAddrToEthAddr decodes a BH Address that was encoded with EthAddrToHBCAddr and verifies the Checksum.
+func HBCAddrToEthAddr(input string) (result []byte, err error) {
+	return CurrentAddrPrefix().Decode(input)
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"sort"
@@ -51,6 +52,12 @@ func (coin Coin) String() string {
 	return fmt.Sprintf("%v%v", coin.Amount, coin.Denom)
 }
 
+// IsNilInt reports whether i is the zero value of sdk.Int, i.e. an amount missing from a
+// decoded message, on which the methods of sdk.Int panic.
+func IsNilInt(i sdk.Int) bool {
+	return i == sdk.Int{}
+}
+
 // validate returns an error if the Coin has a negative amount or if
 // the denom is invalid.
 func validate(denom string, amount sdk.Int) error {
@@ -58,6 +65,9 @@ func validate(denom string, amount sdk.Int) error {
 		return err
 	}
 
+	if IsNilInt(amount) {
+		return errors.New("missing coin amount")
+	}
 	if amount.LT(sdk.ZeroInt()) {
 		return fmt.Errorf("negative coin amount: %v", amount)
 	}
@@ -209,7 +219,7 @@ func (coins Coins) IsValid() bool {
 	case 0:
 		return true
 	case 1:
-		if err := validateDenom(coins[0].Denom); err != nil {
+		if err := validate(coins[0].Denom, coins[0].Amount); err != nil {
 			return false
 		}
 		return coins[0].IsPositive()
@@ -224,7 +234,7 @@ func (coins Coins) IsValid() bool {
 			if coin.Denom <= lowDenom {
 				return false
 			}
-			if !coin.IsPositive() {
+			if IsNilInt(coin.Amount) || !coin.IsPositive() {
 				return false
 			}
 
@@ -607,6 +617,11 @@ func validateDenom(denom string) error {
 	return nil
 }
 
+// ValidateDenom checks a denom or token symbol against the denom format.
+func ValidateDenom(denom string) error {
+	return validateDenom(denom)
+}
+
 func mustValidateDenom(denom string) {
 	if err := validateDenom(denom); err != nil {
 		panic(err)
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	reDecimalAmt  = regexp.MustCompile(fmt.Sprintf(`^(%s|%s)$`, reDecAmt, reAmt))
+	reDecimalCoin = regexp.MustCompile(fmt.Sprintf(`^(%s|%s)%s(%s)$`, reDecAmt, reAmt, reSpc, reDnmString))
+)
+
+// ParseDecimalAmount converts a decimal amount, i.e. "1.5", to base units of a token with decimals.
+// It fails rather than rounds when the amount has more fractional digits than decimals.
+func ParseDecimalAmount(amount string, decimals uint64) (sdk.Int, error) {
+	if decimals > MaxTokenDecimals {
+		return sdk.Int{}, fmt.Errorf("decimals %d exceeds %d", decimals, MaxTokenDecimals)
+	}
+	amount = strings.TrimSpace(amount)
+	if !reDecimalAmt.MatchString(amount) {
+		return sdk.Int{}, fmt.Errorf("invalid decimal amount: %s", amount)
+	}
+
+	intPart, fracPart := amount, ""
+	if i := strings.IndexByte(amount, '.'); i >= 0 {
+		intPart, fracPart = amount[:i], amount[i+1:]
+	}
+
+	fracPart = strings.TrimRight(fracPart, "0")
+	if uint64(len(fracPart)) > decimals {
+		return sdk.Int{}, fmt.Errorf("amount %s has more than %d decimals", amount, decimals)
+	}
+
+	units, ok := sdk.NewIntFromString(intPart + fracPart + strings.Repeat("0", int(decimals)-len(fracPart)))
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid decimal amount: %s", amount)
+	}
+	return units, nil
+}
+
+// FormatDecimalAmount converts base units of a token with decimals to a decimal amount,
+// i.e. 1500000000000000000 with 18 decimals to "1.5". A missing amount is formatted as "0".
+func FormatDecimalAmount(units sdk.Int, decimals uint64) string {
+	if IsNilInt(units) {
+		return "0"
+	}
+	sign := ""
+	if units.IsNegative() {
+		sign = "-"
+		units = units.Neg()
+	}
+	s := units.String()
+	if decimals == 0 {
+		return sign + s
+	}
+
+	if uint64(len(s)) <= decimals {
+		s = strings.Repeat("0", int(decimals)-len(s)+1) + s
+	}
+
+	intPart, fracPart := s[:len(s)-int(decimals)], strings.TrimRight(s[len(s)-int(decimals):], "0")
+	if fracPart == "" {
+		return sign + intPart
+	}
+	return sign + intPart + "." + fracPart
+}
+
+// ParseDecimalCoin splits a decimal coin expression, i.e. "1.5 hbc", into its amount and denom.
+func ParseDecimalCoin(coinStr string) (amount string, denom string, err error) {
+	coinStr = strings.TrimSpace(coinStr)
+
+	matches := reDecimalCoin.FindStringSubmatch(coinStr)
+	if matches == nil {
+		return "", "", fmt.Errorf("invalid coin expression: %s", coinStr)
+	}
+
+	return matches[1], matches[2], nil
+}
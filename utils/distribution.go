@@ -0,0 +1,136 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const DistributionRoute = "distribution"
+
+// MsgSetWithdrawAddress - struct for changing the address receiving the rewards
+type MsgSetWithdrawAddress struct {
+	DelegatorAddress CUAddress `json:"delegator_address" yaml:"delegator_address"`
+	WithdrawAddress  CUAddress `json:"withdraw_address" yaml:"withdraw_address"`
+}
+
+var _ Msg = MsgSetWithdrawAddress{}
+
+// NewMsgSetWithdrawAddress - construct a set withdraw address msg.
+func NewMsgSetWithdrawAddress(delAddr, withdrawAddr CUAddress) MsgSetWithdrawAddress {
+	return MsgSetWithdrawAddress{DelegatorAddress: delAddr, WithdrawAddress: withdrawAddr}
+}
+
+// Route Implements Msg.
+func (msg MsgSetWithdrawAddress) Route() string { return DistributionRoute }
+
+// Type Implements Msg.
+func (msg MsgSetWithdrawAddress) Type() string { return "set_withdraw_address" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetWithdrawAddress) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing delegator address")
+	}
+	if msg.WithdrawAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing withdraw address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetWithdrawAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetWithdrawAddress) GetSigners() []CUAddress {
+	return []CUAddress{msg.DelegatorAddress}
+}
+
+func (msg MsgSetWithdrawAddress) GetInvolvedAddresses() []CUAddress {
+	return []CUAddress{msg.DelegatorAddress, msg.WithdrawAddress}
+}
+
+// MsgWithdrawDelegatorReward - struct for withdrawing the rewards of a delegation
+type MsgWithdrawDelegatorReward struct {
+	DelegatorAddress CUAddress  `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+var _ Msg = MsgWithdrawDelegatorReward{}
+
+// NewMsgWithdrawDelegatorReward - construct a withdraw delegator reward msg.
+func NewMsgWithdrawDelegatorReward(delAddr CUAddress, valAddr ValAddress) MsgWithdrawDelegatorReward {
+	return MsgWithdrawDelegatorReward{DelegatorAddress: delAddr, ValidatorAddress: valAddr}
+}
+
+// Route Implements Msg.
+func (msg MsgWithdrawDelegatorReward) Route() string { return DistributionRoute }
+
+// Type Implements Msg.
+func (msg MsgWithdrawDelegatorReward) Type() string { return "withdraw_delegator_reward" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing delegator address")
+	}
+	if msg.ValidatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing validator address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgWithdrawDelegatorReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgWithdrawDelegatorReward) GetSigners() []CUAddress {
+	return []CUAddress{msg.DelegatorAddress}
+}
+
+func (msg MsgWithdrawDelegatorReward) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// MsgWithdrawValidatorCommission - struct for withdrawing the commission of a validator
+type MsgWithdrawValidatorCommission struct {
+	ValidatorAddress ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+var _ Msg = MsgWithdrawValidatorCommission{}
+
+// NewMsgWithdrawValidatorCommission - construct a withdraw validator commission msg.
+func NewMsgWithdrawValidatorCommission(valAddr ValAddress) MsgWithdrawValidatorCommission {
+	return MsgWithdrawValidatorCommission{ValidatorAddress: valAddr}
+}
+
+// Route Implements Msg.
+func (msg MsgWithdrawValidatorCommission) Route() string { return DistributionRoute }
+
+// Type Implements Msg.
+func (msg MsgWithdrawValidatorCommission) Type() string { return "withdraw_validator_commission" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgWithdrawValidatorCommission) ValidateBasic() error {
+	if msg.ValidatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing validator address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgWithdrawValidatorCommission) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgWithdrawValidatorCommission) GetSigners() []CUAddress {
+	return []CUAddress{CUAddress(msg.ValidatorAddress)}
+}
+
+func (msg MsgWithdrawValidatorCommission) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
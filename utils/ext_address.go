@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"golang.org/x/crypto/sha3"
+)
+
+// chains with local address validation
+const (
+	ChainBTC = "btc"
+	ChainETH = "eth"
+)
+
+// ExtNetwork selects the network of the external chains, i.e. bitcoin mainnet or testnet3.
+type ExtNetwork string
+
+const (
+	ExtMainnet ExtNetwork = "mainnet"
+	ExtTestnet ExtNetwork = "testnet"
+)
+
+// Validate checks the network is known, empty stands for ExtMainnet.
+func (n ExtNetwork) Validate() error {
+	switch n {
+	case "", ExtMainnet, ExtTestnet:
+		return nil
+	default:
+		return fmt.Errorf("unknown external network: %s", n)
+	}
+}
+
+func (n ExtNetwork) btcParams() *chaincfg.Params {
+	if n == ExtTestnet {
+		return &chaincfg.TestNet3Params
+	}
+	return &chaincfg.MainNetParams
+}
+
+// ValidateExternalAddress checks the address format of chain on network, the chains without
+// local validation are rejected.
+func ValidateExternalAddress(network ExtNetwork, chain, address string) error {
+	if err := network.Validate(); err != nil {
+		return err
+	}
+	switch strings.ToLower(chain) {
+	case ChainBTC:
+		return validateBTCAddress(network.btcParams(), address)
+	case ChainETH:
+		return validateETHAddress(address)
+	default:
+		return fmt.Errorf("unknown chain: %s", chain)
+	}
+}
+
+// validateBTCAddress accepts the P2PKH, P2SH and segwit addresses of params.
+func validateBTCAddress(params *chaincfg.Params, address string) error {
+	addr, err := btcutil.DecodeAddress(address, params)
+	if err != nil || !addr.IsForNet(params) {
+		return fmt.Errorf("invalid btc address: %s", address)
+	}
+	switch addr.(type) {
+	case *btcutil.AddressPubKeyHash, *btcutil.AddressScriptHash,
+		*btcutil.AddressWitnessPubKeyHash, *btcutil.AddressWitnessScriptHash:
+		return nil
+	default:
+		return fmt.Errorf("btc address %s is no P2PKH, P2SH or segwit address", address)
+	}
+}
+
+func validateETHAddress(address string) error {
+	if len(address) != 42 || !strings.HasPrefix(address, "0x") {
+		return fmt.Errorf("invalid eth address: %s", address)
+	}
+	hexAddr := address[2:]
+	if _, err := hex.DecodeString(hexAddr); err != nil {
+		return fmt.Errorf("invalid eth address: %s", address)
+	}
+
+	// mixed case addresses carry an EIP-55 checksum
+	if hexAddr == strings.ToLower(hexAddr) || hexAddr == strings.ToUpper(hexAddr) {
+		return nil
+	}
+	if address != toETHChecksumAddress(hexAddr) {
+		return fmt.Errorf("eth address checksum mismatch: %s", address)
+	}
+	return nil
+}
+
+func toETHChecksumAddress(hexAddr string) string {
+	hexAddr = strings.ToLower(hexAddr)
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write([]byte(hexAddr))
+	hash := hex.EncodeToString(hasher.Sum(nil))
+
+	checksummed := []byte(hexAddr)
+	for i, c := range checksummed {
+		if c >= 'a' && hash[i] >= '8' {
+			checksummed[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(checksummed)
+}
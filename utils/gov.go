@@ -0,0 +1,356 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	GovRoute    = "gov"
+	ParamsRoute = "params"
+
+	ProposalTypeText            = "Text"
+	ProposalTypeParameterChange = "ParameterChange"
+
+	MaxTitleLength       = 140
+	MaxDescriptionLength = 5000
+)
+
+// Content defines an interface that a proposal must implement.
+type Content interface {
+	GetTitle() string
+	GetDescription() string
+	ProposalRoute() string
+	ProposalType() string
+	ValidateBasic() error
+	String() string
+}
+
+// ValidateAbstract validates the title and the description of a proposal content.
+func ValidateAbstract(c Content) error {
+	title := c.GetTitle()
+	if len(strings.TrimSpace(title)) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal title cannot be blank")
+	}
+	if len(title) > MaxTitleLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "proposal title is longer than max length of %d", MaxTitleLength)
+	}
+
+	description := c.GetDescription()
+	if len(description) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal description cannot be blank")
+	}
+	if len(description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "proposal description is longer than max length of %d", MaxDescriptionLength)
+	}
+	return nil
+}
+
+// TextProposal defines a standard text proposal whose changes need to be
+// manually updated in case of approval
+type TextProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+}
+
+var _ Content = TextProposal{}
+
+// NewTextProposal creates a text proposal Content
+func NewTextProposal(title, description string) TextProposal {
+	return TextProposal{Title: title, Description: description}
+}
+
+func (tp TextProposal) GetTitle() string       { return tp.Title }
+func (tp TextProposal) GetDescription() string { return tp.Description }
+func (tp TextProposal) ProposalRoute() string  { return GovRoute }
+func (tp TextProposal) ProposalType() string   { return ProposalTypeText }
+func (tp TextProposal) ValidateBasic() error   { return ValidateAbstract(tp) }
+
+func (tp TextProposal) String() string {
+	return fmt.Sprintf("Text Proposal:\n  Title:       %s\n  Description: %s\n", tp.Title, tp.Description)
+}
+
+// ParamChange defines a parameter change.
+type ParamChange struct {
+	Subspace string `json:"subspace" yaml:"subspace"`
+	Key      string `json:"key" yaml:"key"`
+	Value    string `json:"value" yaml:"value"`
+}
+
+func NewParamChange(subspace, key, value string) ParamChange {
+	return ParamChange{Subspace: subspace, Key: key, Value: value}
+}
+
+// ParameterChangeProposal defines a proposal which contains multiple parameter
+// changes.
+type ParameterChangeProposal struct {
+	Title       string        `json:"title" yaml:"title"`
+	Description string        `json:"description" yaml:"description"`
+	Changes     []ParamChange `json:"changes" yaml:"changes"`
+}
+
+var _ Content = ParameterChangeProposal{}
+
+// NewParameterChangeProposal creates a parameter change proposal Content
+func NewParameterChangeProposal(title, description string, changes []ParamChange) ParameterChangeProposal {
+	return ParameterChangeProposal{Title: title, Description: description, Changes: changes}
+}
+
+func (pcp ParameterChangeProposal) GetTitle() string       { return pcp.Title }
+func (pcp ParameterChangeProposal) GetDescription() string { return pcp.Description }
+func (pcp ParameterChangeProposal) ProposalRoute() string  { return ParamsRoute }
+func (pcp ParameterChangeProposal) ProposalType() string   { return ProposalTypeParameterChange }
+
+func (pcp ParameterChangeProposal) ValidateBasic() error {
+	if err := ValidateAbstract(pcp); err != nil {
+		return err
+	}
+	if len(pcp.Changes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "submitted parameter changes are empty")
+	}
+
+	for _, pc := range pcp.Changes {
+		if len(pc.Subspace) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "parameter change subspace cannot be blank")
+		}
+		if len(pc.Key) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "parameter change key cannot be blank")
+		}
+		if len(pc.Value) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "parameter change value cannot be blank")
+		}
+	}
+	return nil
+}
+
+func (pcp ParameterChangeProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("Parameter Change Proposal:\n  Title:       %s\n  Description: %s\n  Changes:\n", pcp.Title, pcp.Description))
+	for _, pc := range pcp.Changes {
+		b.WriteString(fmt.Sprintf("    Param Change:\n      Subspace: %s\n      Key:      %s\n      Value:    %s\n", pc.Subspace, pc.Key, pc.Value))
+	}
+	return b.String()
+}
+
+// VoteOption defines a vote option
+type VoteOption byte
+
+const (
+	OptionEmpty      VoteOption = 0x00
+	OptionYes        VoteOption = 0x01
+	OptionAbstain    VoteOption = 0x02
+	OptionNo         VoteOption = 0x03
+	OptionNoWithVeto VoteOption = 0x04
+)
+
+// VoteOptionFromString returns a VoteOption from a string. It returns an error
+// if the string is invalid.
+func VoteOptionFromString(str string) (VoteOption, error) {
+	switch strings.ToLower(str) {
+	case "yes":
+		return OptionYes, nil
+	case "abstain":
+		return OptionAbstain, nil
+	case "no":
+		return OptionNo, nil
+	case "nowithveto", "no_with_veto":
+		return OptionNoWithVeto, nil
+	default:
+		return VoteOption(0xff), fmt.Errorf("'%s' is not a valid vote option", str)
+	}
+}
+
+// ValidVoteOption returns true if the vote option is valid and false otherwise.
+func ValidVoteOption(option VoteOption) bool {
+	return option == OptionYes ||
+		option == OptionAbstain ||
+		option == OptionNo ||
+		option == OptionNoWithVeto
+}
+
+// MarshalJSON Marshals to JSON using string.
+func (vo VoteOption) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vo.String())
+}
+
+// UnmarshalJSON decodes from JSON using string.
+func (vo *VoteOption) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := VoteOptionFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*vo = bz2
+	return nil
+}
+
+// String implements the Stringer interface.
+func (vo VoteOption) String() string {
+	switch vo {
+	case OptionYes:
+		return "Yes"
+	case OptionAbstain:
+		return "Abstain"
+	case OptionNo:
+		return "No"
+	case OptionNoWithVeto:
+		return "NoWithVeto"
+	default:
+		return ""
+	}
+}
+
+// MsgSubmitProposal - struct for submitting a proposal with an initial deposit
+type MsgSubmitProposal struct {
+	Content        Content   `json:"content" yaml:"content"`
+	InitialDeposit Coins     `json:"initial_deposit" yaml:"initial_deposit"`
+	Proposer       CUAddress `json:"proposer" yaml:"proposer"`
+}
+
+var _ Msg = MsgSubmitProposal{}
+
+// NewMsgSubmitProposal - construct a submit proposal msg.
+func NewMsgSubmitProposal(content Content, initialDeposit Coins, proposer CUAddress) MsgSubmitProposal {
+	return MsgSubmitProposal{Content: content, InitialDeposit: initialDeposit, Proposer: proposer}
+}
+
+// Route Implements Msg.
+func (msg MsgSubmitProposal) Route() string { return GovRoute }
+
+// Type Implements Msg.
+func (msg MsgSubmitProposal) Type() string { return "submit_proposal" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSubmitProposal) ValidateBasic() error {
+	if msg.Content == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing content")
+	}
+	if msg.Proposer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing proposer address")
+	}
+	if !msg.InitialDeposit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "InitialDeposit inValid")
+	}
+	if msg.InitialDeposit.IsAnyNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "InitialDeposit inValid")
+	}
+	return msg.Content.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSubmitProposal) GetSigners() []CUAddress {
+	return []CUAddress{msg.Proposer}
+}
+
+func (msg MsgSubmitProposal) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// MsgDeposit - struct for adding a deposit to a proposal
+type MsgDeposit struct {
+	ProposalID uint64    `json:"proposal_id" yaml:"proposal_id"`
+	Depositor  CUAddress `json:"depositor" yaml:"depositor"`
+	Amount     Coins     `json:"amount" yaml:"amount"`
+}
+
+var _ Msg = MsgDeposit{}
+
+// NewMsgDeposit - construct a deposit msg.
+func NewMsgDeposit(depositor CUAddress, proposalID uint64, amount Coins) MsgDeposit {
+	return MsgDeposit{ProposalID: proposalID, Depositor: depositor, Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgDeposit) Route() string { return GovRoute }
+
+// Type Implements Msg.
+func (msg MsgDeposit) Type() string { return "deposit" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgDeposit) ValidateBasic() error {
+	if msg.Depositor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing depositor address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Amount inValid")
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Amount inValid")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDeposit) GetSigners() []CUAddress {
+	return []CUAddress{msg.Depositor}
+}
+
+func (msg MsgDeposit) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// MsgVote - struct for voting on a proposal
+type MsgVote struct {
+	ProposalID uint64     `json:"proposal_id" yaml:"proposal_id"`
+	Voter      CUAddress  `json:"voter" yaml:"voter"`
+	Option     VoteOption `json:"option" yaml:"option"`
+}
+
+var _ Msg = MsgVote{}
+
+// NewMsgVote - construct a vote msg.
+func NewMsgVote(voter CUAddress, proposalID uint64, option VoteOption) MsgVote {
+	return MsgVote{ProposalID: proposalID, Voter: voter, Option: option}
+}
+
+// Route Implements Msg.
+func (msg MsgVote) Route() string { return GovRoute }
+
+// Type Implements Msg.
+func (msg MsgVote) Type() string { return "vote" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgVote) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing voter address")
+	}
+	if !ValidVoteOption(msg.Option) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid vote option %d", msg.Option)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgVote) GetSigners() []CUAddress {
+	return []CUAddress{msg.Voter}
+}
+
+func (msg MsgVote) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
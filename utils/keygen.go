@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const KeyGenRoute = "keygen"
+
+// NewOrderID returns a random UUID identifying the order created by a msg.
+func NewOrderID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// MsgKeyGen - struct for requesting the deposit address of To on the chain of Symbol
+type MsgKeyGen struct {
+	OrderID string    `json:"order_id" yaml:"order_id"`
+	Symbol  string    `json:"symbol" yaml:"symbol"`
+	From    CUAddress `json:"from" yaml:"from"`
+	To      CUAddress `json:"to" yaml:"to"`
+}
+
+var _ Msg = MsgKeyGen{}
+
+// NewMsgKeyGen - construct a keygen msg.
+func NewMsgKeyGen(orderID, symbol string, from, to CUAddress) MsgKeyGen {
+	return MsgKeyGen{OrderID: orderID, Symbol: symbol, From: from, To: to}
+}
+
+// Route Implements Msg.
+func (msg MsgKeyGen) Route() string { return KeyGenRoute }
+
+// Type Implements Msg.
+func (msg MsgKeyGen) Type() string { return "keygen" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgKeyGen) ValidateBasic() error {
+	if msg.OrderID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing order id")
+	}
+	if err := validateDenom(msg.Symbol); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if msg.To.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing to address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgKeyGen) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgKeyGen) GetSigners() []CUAddress {
+	return []CUAddress{msg.From}
+}
+
+func (msg MsgKeyGen) GetInvolvedAddresses() []CUAddress {
+	return []CUAddress{msg.From, msg.To}
+}
@@ -0,0 +1,278 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const OpenswapRoute = "openswap"
+
+func validatePair(tokenA, tokenB string) error {
+	if err := validateDenom(tokenA); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if err := validateDenom(tokenB); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if tokenA == tokenB {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "identical tokens")
+	}
+	return nil
+}
+
+func validateMinAmount(amount sdk.Int, name string) error {
+	if IsNilInt(amount) || amount.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s inValid", name)
+	}
+	return nil
+}
+
+// MsgAddLiquidity - struct for depositing a token pair into its swap pool
+type MsgAddLiquidity struct {
+	From       CUAddress `json:"from" yaml:"from"`
+	AmountA    Coin      `json:"amount_a" yaml:"amount_a"`
+	AmountB    Coin      `json:"amount_b" yaml:"amount_b"`
+	MinAmountA sdk.Int   `json:"min_amount_a" yaml:"min_amount_a"`
+	MinAmountB sdk.Int   `json:"min_amount_b" yaml:"min_amount_b"`
+	Deadline   int64     `json:"deadline" yaml:"deadline"`
+}
+
+var _ Msg = MsgAddLiquidity{}
+
+// NewMsgAddLiquidity - construct an add liquidity msg. amountA and amountB are the desired
+// deposits, the pool takes no less than minAmountA and minAmountB before deadline, a unix time.
+func NewMsgAddLiquidity(from CUAddress, amountA, amountB Coin, minAmountA, minAmountB sdk.Int, deadline int64) MsgAddLiquidity {
+	return MsgAddLiquidity{
+		From:       from,
+		AmountA:    amountA,
+		AmountB:    amountB,
+		MinAmountA: minAmountA,
+		MinAmountB: minAmountB,
+		Deadline:   deadline,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgAddLiquidity) Route() string { return OpenswapRoute }
+
+// Type Implements Msg.
+func (msg MsgAddLiquidity) Type() string { return "add_liquidity" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgAddLiquidity) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if !msg.AmountA.IsValid() || !msg.AmountA.IsPositive() || !msg.AmountB.IsValid() || !msg.AmountB.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Amount inValid")
+	}
+	if err := validatePair(msg.AmountA.Denom, msg.AmountB.Denom); err != nil {
+		return err
+	}
+	if err := validateMinAmount(msg.MinAmountA, "MinAmountA"); err != nil {
+		return err
+	}
+	if err := validateMinAmount(msg.MinAmountB, "MinAmountB"); err != nil {
+		return err
+	}
+	if msg.MinAmountA.GT(msg.AmountA.Amount) || msg.MinAmountB.GT(msg.AmountB.Amount) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "min amount exceeds desired amount")
+	}
+	if msg.Deadline <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing deadline")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAddLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAddLiquidity) GetSigners() []CUAddress {
+	return []CUAddress{msg.From}
+}
+
+func (msg MsgAddLiquidity) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// MsgRemoveLiquidity - struct for redeeming liquidity of a swap pool
+type MsgRemoveLiquidity struct {
+	From       CUAddress `json:"from" yaml:"from"`
+	TokenA     string    `json:"token_a" yaml:"token_a"`
+	TokenB     string    `json:"token_b" yaml:"token_b"`
+	Liquidity  sdk.Int   `json:"liquidity" yaml:"liquidity"`
+	MinAmountA sdk.Int   `json:"min_amount_a" yaml:"min_amount_a"`
+	MinAmountB sdk.Int   `json:"min_amount_b" yaml:"min_amount_b"`
+	Deadline   int64     `json:"deadline" yaml:"deadline"`
+}
+
+var _ Msg = MsgRemoveLiquidity{}
+
+// NewMsgRemoveLiquidity - construct a remove liquidity msg.
+func NewMsgRemoveLiquidity(from CUAddress, tokenA, tokenB string, liquidity, minAmountA, minAmountB sdk.Int, deadline int64) MsgRemoveLiquidity {
+	return MsgRemoveLiquidity{
+		From:       from,
+		TokenA:     tokenA,
+		TokenB:     tokenB,
+		Liquidity:  liquidity,
+		MinAmountA: minAmountA,
+		MinAmountB: minAmountB,
+		Deadline:   deadline,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRemoveLiquidity) Route() string { return OpenswapRoute }
+
+// Type Implements Msg.
+func (msg MsgRemoveLiquidity) Type() string { return "remove_liquidity" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRemoveLiquidity) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if err := validatePair(msg.TokenA, msg.TokenB); err != nil {
+		return err
+	}
+	if IsNilInt(msg.Liquidity) || !msg.Liquidity.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Liquidity inValid")
+	}
+	if err := validateMinAmount(msg.MinAmountA, "MinAmountA"); err != nil {
+		return err
+	}
+	if err := validateMinAmount(msg.MinAmountB, "MinAmountB"); err != nil {
+		return err
+	}
+	if msg.Deadline <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing deadline")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRemoveLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRemoveLiquidity) GetSigners() []CUAddress {
+	return []CUAddress{msg.From}
+}
+
+func (msg MsgRemoveLiquidity) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// MsgSwapExactIn - struct for swapping all of AmountIn for at least MinAmountOut
+type MsgSwapExactIn struct {
+	From         CUAddress `json:"from" yaml:"from"`
+	AmountIn     Coin      `json:"amount_in" yaml:"amount_in"`
+	MinAmountOut Coin      `json:"min_amount_out" yaml:"min_amount_out"`
+	Deadline     int64     `json:"deadline" yaml:"deadline"`
+}
+
+var _ Msg = MsgSwapExactIn{}
+
+// NewMsgSwapExactIn - construct a swap exact in msg.
+func NewMsgSwapExactIn(from CUAddress, amountIn, minAmountOut Coin, deadline int64) MsgSwapExactIn {
+	return MsgSwapExactIn{From: from, AmountIn: amountIn, MinAmountOut: minAmountOut, Deadline: deadline}
+}
+
+// Route Implements Msg.
+func (msg MsgSwapExactIn) Route() string { return OpenswapRoute }
+
+// Type Implements Msg.
+func (msg MsgSwapExactIn) Type() string { return "swap_exact_in" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSwapExactIn) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if !msg.AmountIn.IsValid() || !msg.AmountIn.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "AmountIn inValid")
+	}
+	if !msg.MinAmountOut.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "MinAmountOut inValid")
+	}
+	if err := validatePair(msg.AmountIn.Denom, msg.MinAmountOut.Denom); err != nil {
+		return err
+	}
+	if msg.Deadline <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing deadline")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSwapExactIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSwapExactIn) GetSigners() []CUAddress {
+	return []CUAddress{msg.From}
+}
+
+func (msg MsgSwapExactIn) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// MsgSwapExactOut - struct for swapping at most MaxAmountIn for exactly AmountOut
+type MsgSwapExactOut struct {
+	From        CUAddress `json:"from" yaml:"from"`
+	AmountOut   Coin      `json:"amount_out" yaml:"amount_out"`
+	MaxAmountIn Coin      `json:"max_amount_in" yaml:"max_amount_in"`
+	Deadline    int64     `json:"deadline" yaml:"deadline"`
+}
+
+var _ Msg = MsgSwapExactOut{}
+
+// NewMsgSwapExactOut - construct a swap exact out msg.
+func NewMsgSwapExactOut(from CUAddress, amountOut, maxAmountIn Coin, deadline int64) MsgSwapExactOut {
+	return MsgSwapExactOut{From: from, AmountOut: amountOut, MaxAmountIn: maxAmountIn, Deadline: deadline}
+}
+
+// Route Implements Msg.
+func (msg MsgSwapExactOut) Route() string { return OpenswapRoute }
+
+// Type Implements Msg.
+func (msg MsgSwapExactOut) Type() string { return "swap_exact_out" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSwapExactOut) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if !msg.AmountOut.IsValid() || !msg.AmountOut.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "AmountOut inValid")
+	}
+	if !msg.MaxAmountIn.IsValid() || !msg.MaxAmountIn.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "MaxAmountIn inValid")
+	}
+	if err := validatePair(msg.MaxAmountIn.Denom, msg.AmountOut.Denom); err != nil {
+		return err
+	}
+	if msg.Deadline <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing deadline")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSwapExactOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSwapExactOut) GetSigners() []CUAddress {
+	return []CUAddress{msg.From}
+}
+
+func (msg MsgSwapExactOut) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/zxinuoke/hbc-sdk/utils/base58"
+)
+
+const (
+	// PubKeyStrPrefix is the prefix of a base58 encoded public key
+	PubKeyStrPrefix = "BHPubKey:"
+
+	// bech32 strings are limited to 90 characters
+	bech32MaxLen = 90
+)
+
+// PubKeyFromString decodes a public key encoded by PubkeyToString.
+// Multisig public keys are supported.
+func PubKeyFromString(pubkeyStr string) (crypto.PubKey, error) {
+	if !strings.HasPrefix(pubkeyStr, PubKeyStrPrefix) {
+		return nil, fmt.Errorf("invalid pubkey:%v with prefixed !=%v", pubkeyStr, PubKeyStrPrefix)
+	}
+
+	bz := base58.Decode(strings.TrimPrefix(pubkeyStr, PubKeyStrPrefix))
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("invalid base58 pubkey:%v", pubkeyStr)
+	}
+
+	return cryptoAmino.PubKeyFromBytes(bz)
+}
+
+// Bech32ifyPubKey returns the bech32 encoding of a public key with the given prefix.
+// Multisig public keys are too long for bech32, use PubkeyToString for them.
+func Bech32ifyPubKey(prefix string, pubkey crypto.PubKey) (string, error) {
+	bech, err := base58.ConvertAndEncode(prefix, pubkey.Bytes())
+	if err != nil {
+		return "", err
+	}
+	if len(bech) > bech32MaxLen {
+		return "", fmt.Errorf("pubkey too long for bech32 encoding: %d characters", len(bech))
+	}
+	return bech, nil
+}
+
+// GetPubKeyFromBech32 decodes a bech32 public key and checks its prefix.
+func GetPubKeyFromBech32(prefix string, pubkeyStr string) (crypto.PubKey, error) {
+	bz, err := GetFromBech32(pubkeyStr, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return cryptoAmino.PubKeyFromBytes(bz)
+}
+
+// Bech32ifyAccPub returns the bech32 encoding of a CU public key, prefixed with hbcpub.
+func Bech32ifyAccPub(pubkey crypto.PubKey) (string, error) {
+	return Bech32ifyPubKey(Bech32PrefixAccPub, pubkey)
+}
+
+// GetAccPubKeyBech32 decodes a CU public key prefixed with hbcpub.
+func GetAccPubKeyBech32(pubkeyStr string) (crypto.PubKey, error) {
+	return GetPubKeyFromBech32(Bech32PrefixAccPub, pubkeyStr)
+}
+
+// Bech32ifyValPub returns the bech32 encoding of a validator operator public key.
+func Bech32ifyValPub(pubkey crypto.PubKey) (string, error) {
+	return Bech32ifyPubKey(Bech32PrefixValPub, pubkey)
+}
+
+// GetValPubKeyBech32 decodes a validator operator public key.
+func GetValPubKeyBech32(pubkeyStr string) (crypto.PubKey, error) {
+	return GetPubKeyFromBech32(Bech32PrefixValPub, pubkeyStr)
+}
+
+// Bech32ifyConsPub returns the bech32 encoding of a validator consensus public key.
+func Bech32ifyConsPub(pubkey crypto.PubKey) (string, error) {
+	return Bech32ifyPubKey(Bech32PrefixConsPub, pubkey)
+}
+
+// GetConsPubKeyBech32 decodes a validator consensus public key.
+func GetConsPubKeyBech32(pubkeyStr string) (crypto.PubKey, error) {
+	return GetPubKeyFromBech32(Bech32PrefixConsPub, pubkeyStr)
+}
+
+// ParsePubKey decodes a CU public key in either the BHPubKey or the bech32 form.
+func ParsePubKey(pubkeyStr string) (crypto.PubKey, error) {
+	pubkeyStr = strings.TrimSpace(pubkeyStr)
+	if strings.HasPrefix(pubkeyStr, PubKeyStrPrefix) {
+		return PubKeyFromString(pubkeyStr)
+	}
+	return GetAccPubKeyBech32(pubkeyStr)
+}
+
+// CUAddressFromPubKeyString returns the address of a public key in either the BHPubKey or the bech32 form.
+func CUAddressFromPubKeyString(pubkeyStr string) (CUAddress, error) {
+	pubkey, err := ParsePubKey(pubkeyStr)
+	if err != nil {
+		return nil, err
+	}
+	return CUAddressFromPubKey(pubkey), nil
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const SlashingRoute = "slashing"
+
+// MsgUnjail - struct for unjailing a jailed validator
+type MsgUnjail struct {
+	ValidatorAddr ValAddress `json:"address" yaml:"address"`
+}
+
+var _ Msg = MsgUnjail{}
+
+// NewMsgUnjail - construct an unjail msg.
+func NewMsgUnjail(validatorAddr ValAddress) MsgUnjail {
+	return MsgUnjail{ValidatorAddr: validatorAddr}
+}
+
+// Route Implements Msg.
+func (msg MsgUnjail) Route() string { return SlashingRoute }
+
+// Type Implements Msg.
+func (msg MsgUnjail) Type() string { return "unjail" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUnjail) ValidateBasic() error {
+	if msg.ValidatorAddr.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing validator address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnjail) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnjail) GetSigners() []CUAddress {
+	return []CUAddress{CUAddress(msg.ValidatorAddr)}
+}
+
+func (msg MsgUnjail) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
@@ -0,0 +1,451 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const StakingRoute = "staking"
+
+// constant used in flags to indicate that description field should not be updated
+const DoNotModifyDesc = "[do-not-modify]"
+
+// max lengths of the description fields
+const (
+	MaxMonikerLength         = 70
+	MaxIdentityLength        = 3000
+	MaxWebsiteLength         = 140
+	MaxSecurityContactLength = 140
+	MaxDetailsLength         = 280
+)
+
+// Description - description fields for a validator
+type Description struct {
+	Moniker         string `json:"moniker" yaml:"moniker"`
+	Identity        string `json:"identity" yaml:"identity"`
+	Website         string `json:"website" yaml:"website"`
+	SecurityContact string `json:"security_contact" yaml:"security_contact"`
+	Details         string `json:"details" yaml:"details"`
+}
+
+func NewDescription(moniker, identity, website, securityContact, details string) Description {
+	return Description{
+		Moniker:         moniker,
+		Identity:        identity,
+		Website:         website,
+		SecurityContact: securityContact,
+		Details:         details,
+	}
+}
+
+// EnsureLength ensures the length of a validator's description.
+func (d Description) EnsureLength() (Description, error) {
+	if len(d.Moniker) > MaxMonikerLength {
+		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid moniker length; got: %d, max: %d", len(d.Moniker), MaxMonikerLength)
+	}
+	if len(d.Identity) > MaxIdentityLength {
+		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid identity length; got: %d, max: %d", len(d.Identity), MaxIdentityLength)
+	}
+	if len(d.Website) > MaxWebsiteLength {
+		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid website length; got: %d, max: %d", len(d.Website), MaxWebsiteLength)
+	}
+	if len(d.SecurityContact) > MaxSecurityContactLength {
+		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid security contact length; got: %d, max: %d", len(d.SecurityContact), MaxSecurityContactLength)
+	}
+	if len(d.Details) > MaxDetailsLength {
+		return d, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid details length; got: %d, max: %d", len(d.Details), MaxDetailsLength)
+	}
+	return d, nil
+}
+
+// CommissionRates - the commission a validator charges its delegators
+type CommissionRates struct {
+	Rate          sdk.Dec `json:"rate" yaml:"rate"`
+	MaxRate       sdk.Dec `json:"max_rate" yaml:"max_rate"`
+	MaxChangeRate sdk.Dec `json:"max_change_rate" yaml:"max_change_rate"`
+}
+
+func NewCommissionRates(rate, maxRate, maxChangeRate sdk.Dec) CommissionRates {
+	return CommissionRates{Rate: rate, MaxRate: maxRate, MaxChangeRate: maxChangeRate}
+}
+
+// Validate performs basic sanity validation checks of initial commission parameters.
+func (c CommissionRates) Validate() error {
+	switch {
+	case c.Rate.IsNil() || c.MaxRate.IsNil() || c.MaxChangeRate.IsNil():
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission rates must be set")
+	case c.MaxRate.IsNegative():
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission max rate must be positive")
+	case c.MaxRate.GT(sdk.OneDec()):
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission max rate cannot be more than 100%")
+	case c.Rate.IsNegative():
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be positive")
+	case c.Rate.GT(c.MaxRate):
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission rate cannot be more than the max rate")
+	case c.MaxChangeRate.IsNegative():
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission max change rate must be positive")
+	case c.MaxChangeRate.GT(c.MaxRate):
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission max change rate cannot be more than the max rate")
+	}
+	return nil
+}
+
+// MsgDelegate - struct for bonding transactions
+type MsgDelegate struct {
+	DelegatorAddress CUAddress  `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress ValAddress `json:"validator_address" yaml:"validator_address"`
+	Amount           Coin       `json:"amount" yaml:"amount"`
+}
+
+var _ Msg = MsgDelegate{}
+
+// NewMsgDelegate - construct a delegate msg.
+func NewMsgDelegate(delAddr CUAddress, valAddr ValAddress, amount Coin) MsgDelegate {
+	return MsgDelegate{DelegatorAddress: delAddr, ValidatorAddress: valAddr, Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgDelegate) Route() string { return StakingRoute }
+
+// Type Implements Msg.
+func (msg MsgDelegate) Type() string { return "delegate" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgDelegate) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing delegator address")
+	}
+	if msg.ValidatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing validator address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Amount inValid")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgDelegate) GetSigners() []CUAddress {
+	return []CUAddress{msg.DelegatorAddress}
+}
+
+func (msg MsgDelegate) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// MsgUndelegate - struct for unbonding transactions
+type MsgUndelegate struct {
+	DelegatorAddress CUAddress  `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress ValAddress `json:"validator_address" yaml:"validator_address"`
+	Amount           Coin       `json:"amount" yaml:"amount"`
+}
+
+var _ Msg = MsgUndelegate{}
+
+// NewMsgUndelegate - construct an undelegate msg.
+func NewMsgUndelegate(delAddr CUAddress, valAddr ValAddress, amount Coin) MsgUndelegate {
+	return MsgUndelegate{DelegatorAddress: delAddr, ValidatorAddress: valAddr, Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgUndelegate) Route() string { return StakingRoute }
+
+// Type Implements Msg.
+func (msg MsgUndelegate) Type() string { return "begin_unbonding" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUndelegate) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing delegator address")
+	}
+	if msg.ValidatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing validator address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Amount inValid")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUndelegate) GetSigners() []CUAddress {
+	return []CUAddress{msg.DelegatorAddress}
+}
+
+func (msg MsgUndelegate) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// MsgBeginRedelegate - struct for moving a delegation from one validator to another
+type MsgBeginRedelegate struct {
+	DelegatorAddress    CUAddress  `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorSrcAddress ValAddress `json:"validator_src_address" yaml:"validator_src_address"`
+	ValidatorDstAddress ValAddress `json:"validator_dst_address" yaml:"validator_dst_address"`
+	Amount              Coin       `json:"amount" yaml:"amount"`
+}
+
+var _ Msg = MsgBeginRedelegate{}
+
+// NewMsgBeginRedelegate - construct a redelegate msg.
+func NewMsgBeginRedelegate(delAddr CUAddress, valSrcAddr, valDstAddr ValAddress, amount Coin) MsgBeginRedelegate {
+	return MsgBeginRedelegate{
+		DelegatorAddress:    delAddr,
+		ValidatorSrcAddress: valSrcAddr,
+		ValidatorDstAddress: valDstAddr,
+		Amount:              amount,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgBeginRedelegate) Route() string { return StakingRoute }
+
+// Type Implements Msg.
+func (msg MsgBeginRedelegate) Type() string { return "begin_redelegate" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBeginRedelegate) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing delegator address")
+	}
+	if msg.ValidatorSrcAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing source validator address")
+	}
+	if msg.ValidatorDstAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing destination validator address")
+	}
+	if bytes.Equal(msg.ValidatorSrcAddress, msg.ValidatorDstAddress) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot redelegate to the same validator")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Amount inValid")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBeginRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBeginRedelegate) GetSigners() []CUAddress {
+	return []CUAddress{msg.DelegatorAddress}
+}
+
+func (msg MsgBeginRedelegate) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// MsgCreateValidator - struct for creating a validator with a self delegation
+type MsgCreateValidator struct {
+	Description       Description     `json:"description" yaml:"description"`
+	Commission        CommissionRates `json:"commission" yaml:"commission"`
+	MinSelfDelegation sdk.Int         `json:"min_self_delegation" yaml:"min_self_delegation"`
+	DelegatorAddress  CUAddress       `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress  ValAddress      `json:"validator_address" yaml:"validator_address"`
+	PubKey            crypto.PubKey   `json:"pubkey" yaml:"pubkey"`
+	Value             Coin            `json:"value" yaml:"value"`
+}
+
+type msgCreateValidatorJSON struct {
+	Description       Description     `json:"description"`
+	Commission        CommissionRates `json:"commission"`
+	MinSelfDelegation sdk.Int         `json:"min_self_delegation"`
+	DelegatorAddress  CUAddress       `json:"delegator_address"`
+	ValidatorAddress  ValAddress      `json:"validator_address"`
+	PubKey            string          `json:"pubkey"`
+	Value             Coin            `json:"value"`
+}
+
+var _ Msg = MsgCreateValidator{}
+
+// NewMsgCreateValidator - construct a create validator msg, the operator self delegates selfDelegation.
+func NewMsgCreateValidator(valAddr ValAddress, pubKey crypto.PubKey, selfDelegation Coin,
+	description Description, commission CommissionRates, minSelfDelegation sdk.Int) MsgCreateValidator {
+	return MsgCreateValidator{
+		Description:       description,
+		DelegatorAddress:  CUAddress(valAddr),
+		ValidatorAddress:  valAddr,
+		PubKey:            pubKey,
+		Value:             selfDelegation,
+		Commission:        commission,
+		MinSelfDelegation: minSelfDelegation,
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface to provide custom JSON
+// serialization of the MsgCreateValidator type, the consensus public key in bech32.
+func (msg MsgCreateValidator) MarshalJSON() ([]byte, error) {
+	pubKey := ""
+	if msg.PubKey != nil {
+		var err error
+		pubKey, err = Bech32ifyConsPub(msg.PubKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(msgCreateValidatorJSON{
+		Description:       msg.Description,
+		Commission:        msg.Commission,
+		MinSelfDelegation: msg.MinSelfDelegation,
+		DelegatorAddress:  msg.DelegatorAddress,
+		ValidatorAddress:  msg.ValidatorAddress,
+		PubKey:            pubKey,
+		Value:             msg.Value,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to provide custom
+// JSON deserialization of the MsgCreateValidator type.
+func (msg *MsgCreateValidator) UnmarshalJSON(bz []byte) error {
+	var msgCreateValJSON msgCreateValidatorJSON
+	if err := json.Unmarshal(bz, &msgCreateValJSON); err != nil {
+		return err
+	}
+
+	msg.Description = msgCreateValJSON.Description
+	msg.Commission = msgCreateValJSON.Commission
+	msg.MinSelfDelegation = msgCreateValJSON.MinSelfDelegation
+	msg.DelegatorAddress = msgCreateValJSON.DelegatorAddress
+	msg.ValidatorAddress = msgCreateValJSON.ValidatorAddress
+	msg.Value = msgCreateValJSON.Value
+
+	var err error
+	msg.PubKey, err = GetConsPubKeyBech32(msgCreateValJSON.PubKey)
+	return err
+}
+
+// Route Implements Msg.
+func (msg MsgCreateValidator) Route() string { return StakingRoute }
+
+// Type Implements Msg.
+func (msg MsgCreateValidator) Type() string { return "create_validator" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateValidator) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing delegator address")
+	}
+	if msg.ValidatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing validator address")
+	}
+	if !bytes.Equal(msg.DelegatorAddress, msg.ValidatorAddress) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator address is invalid")
+	}
+	if msg.PubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing consensus public key")
+	}
+	if !msg.Value.IsValid() || !msg.Value.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Value inValid")
+	}
+	if msg.Description.Moniker == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty description")
+	}
+	if _, err := msg.Description.EnsureLength(); err != nil {
+		return err
+	}
+	if err := msg.Commission.Validate(); err != nil {
+		return err
+	}
+	if IsNilInt(msg.MinSelfDelegation) || !msg.MinSelfDelegation.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "minimum self delegation must be a positive integer")
+	}
+	if msg.Value.Amount.LT(msg.MinSelfDelegation) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "self delegation below minimum")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateValidator) GetSigners() []CUAddress {
+	addrs := []CUAddress{msg.DelegatorAddress}
+	if !bytes.Equal(msg.DelegatorAddress, msg.ValidatorAddress) {
+		addrs = append(addrs, CUAddress(msg.ValidatorAddress))
+	}
+	return addrs
+}
+
+func (msg MsgCreateValidator) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// MsgEditValidator - struct for editing a validator, nil rates are left unchanged
+type MsgEditValidator struct {
+	Description       Description `json:"description" yaml:"description"`
+	ValidatorAddress  ValAddress  `json:"address" yaml:"address"`
+	CommissionRate    *sdk.Dec    `json:"commission_rate" yaml:"commission_rate"`
+	MinSelfDelegation *sdk.Int    `json:"min_self_delegation" yaml:"min_self_delegation"`
+}
+
+var _ Msg = MsgEditValidator{}
+
+// NewMsgEditValidator - construct an edit validator msg. Fields of description set to
+// DoNotModifyDesc are left unchanged.
+func NewMsgEditValidator(valAddr ValAddress, description Description, newRate *sdk.Dec, newMinSelfDelegation *sdk.Int) MsgEditValidator {
+	return MsgEditValidator{
+		Description:       description,
+		CommissionRate:    newRate,
+		ValidatorAddress:  valAddr,
+		MinSelfDelegation: newMinSelfDelegation,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgEditValidator) Route() string { return StakingRoute }
+
+// Type Implements Msg.
+func (msg MsgEditValidator) Type() string { return "edit_validator" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgEditValidator) ValidateBasic() error {
+	if msg.ValidatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing validator address")
+	}
+	if msg.Description == (Description{}) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "transaction must include some information to modify")
+	}
+	if _, err := msg.Description.EnsureLength(); err != nil {
+		return err
+	}
+	if msg.MinSelfDelegation != nil && (IsNilInt(*msg.MinSelfDelegation) || !msg.MinSelfDelegation.IsPositive()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "minimum self delegation must be a positive integer")
+	}
+	if msg.CommissionRate != nil && (msg.CommissionRate.IsNil() || msg.CommissionRate.GT(sdk.OneDec()) || msg.CommissionRate.IsNegative()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgEditValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgEditValidator) GetSigners() []CUAddress {
+	return []CUAddress{CUAddress(msg.ValidatorAddress)}
+}
+
+func (msg MsgEditValidator) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
@@ -0,0 +1,233 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TokenRoute = "token"
+
+// MaxTokenDecimals is the largest precision of a token.
+const MaxTokenDecimals = 18
+
+// MsgNewToken - struct for issuing a token, the total supply is credited to To
+type MsgNewToken struct {
+	From        CUAddress `json:"from" yaml:"from"`
+	To          CUAddress `json:"to" yaml:"to"`
+	Symbol      string    `json:"symbol" yaml:"symbol"`
+	Decimals    uint64    `json:"decimals" yaml:"decimals"`
+	TotalSupply sdk.Int   `json:"total_supply" yaml:"total_supply"`
+}
+
+var _ Msg = MsgNewToken{}
+
+// NewMsgNewToken - construct an issue token msg.
+func NewMsgNewToken(from, to CUAddress, symbol string, decimals uint64, totalSupply sdk.Int) MsgNewToken {
+	return MsgNewToken{From: from, To: to, Symbol: symbol, Decimals: decimals, TotalSupply: totalSupply}
+}
+
+// Route Implements Msg.
+func (msg MsgNewToken) Route() string { return TokenRoute }
+
+// Type Implements Msg.
+func (msg MsgNewToken) Type() string { return "new_token" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgNewToken) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if msg.To.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing to address")
+	}
+	if err := ValidateDenom(msg.Symbol); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if msg.Decimals > MaxTokenDecimals {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "decimals %d exceeds %d", msg.Decimals, MaxTokenDecimals)
+	}
+	if IsNilInt(msg.TotalSupply) || !msg.TotalSupply.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "TotalSupply inValid")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgNewToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgNewToken) GetSigners() []CUAddress {
+	return []CUAddress{msg.From}
+}
+
+func (msg MsgNewToken) GetInvolvedAddresses() []CUAddress {
+	return []CUAddress{msg.From, msg.To}
+}
+
+// MsgInflateToken - struct for minting Amount of a token to To, signed by its issuer
+type MsgInflateToken struct {
+	From   CUAddress `json:"from" yaml:"from"`
+	To     CUAddress `json:"to" yaml:"to"`
+	Symbol string    `json:"symbol" yaml:"symbol"`
+	Amount sdk.Int   `json:"amount" yaml:"amount"`
+}
+
+var _ Msg = MsgInflateToken{}
+
+// NewMsgInflateToken - construct a mint token msg.
+func NewMsgInflateToken(from, to CUAddress, symbol string, amount sdk.Int) MsgInflateToken {
+	return MsgInflateToken{From: from, To: to, Symbol: symbol, Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgInflateToken) Route() string { return TokenRoute }
+
+// Type Implements Msg.
+func (msg MsgInflateToken) Type() string { return "inflate_token" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgInflateToken) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if msg.To.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing to address")
+	}
+	if err := ValidateDenom(msg.Symbol); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if IsNilInt(msg.Amount) || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Amount inValid")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgInflateToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgInflateToken) GetSigners() []CUAddress {
+	return []CUAddress{msg.From}
+}
+
+func (msg MsgInflateToken) GetInvolvedAddresses() []CUAddress {
+	return []CUAddress{msg.From, msg.To}
+}
+
+// MsgBurnToken - struct for burning Amount of a token held by From
+type MsgBurnToken struct {
+	From   CUAddress `json:"from" yaml:"from"`
+	Symbol string    `json:"symbol" yaml:"symbol"`
+	Amount sdk.Int   `json:"amount" yaml:"amount"`
+}
+
+var _ Msg = MsgBurnToken{}
+
+// NewMsgBurnToken - construct a burn token msg.
+func NewMsgBurnToken(from CUAddress, symbol string, amount sdk.Int) MsgBurnToken {
+	return MsgBurnToken{From: from, Symbol: symbol, Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgBurnToken) Route() string { return TokenRoute }
+
+// Type Implements Msg.
+func (msg MsgBurnToken) Type() string { return "burn_token" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurnToken) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if err := ValidateDenom(msg.Symbol); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if IsNilInt(msg.Amount) || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Amount inValid")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurnToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurnToken) GetSigners() []CUAddress {
+	return []CUAddress{msg.From}
+}
+
+func (msg MsgBurnToken) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
+
+// TokenParamChange sets the token parameter Key, i.e. is_send_enabled, to the JSON Value.
+type TokenParamChange struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+func NewTokenParamChange(key, value string) TokenParamChange {
+	return TokenParamChange{Key: key, Value: value}
+}
+
+// MsgTokenParamsChange - struct for changing the parameters of a token, signed by its issuer
+type MsgTokenParamsChange struct {
+	From    CUAddress          `json:"from" yaml:"from"`
+	Symbol  string             `json:"symbol" yaml:"symbol"`
+	Changes []TokenParamChange `json:"changes" yaml:"changes"`
+}
+
+var _ Msg = MsgTokenParamsChange{}
+
+// NewMsgTokenParamsChange - construct a token params change msg.
+func NewMsgTokenParamsChange(from CUAddress, symbol string, changes []TokenParamChange) MsgTokenParamsChange {
+	return MsgTokenParamsChange{From: from, Symbol: symbol, Changes: changes}
+}
+
+// Route Implements Msg.
+func (msg MsgTokenParamsChange) Route() string { return TokenRoute }
+
+// Type Implements Msg.
+func (msg MsgTokenParamsChange) Type() string { return "token_params_change" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTokenParamsChange) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if err := ValidateDenom(msg.Symbol); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if len(msg.Changes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no param changes")
+	}
+	for _, change := range msg.Changes {
+		if change.Key == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty param key")
+		}
+		if change.Value == "" {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "empty value of param %s", change.Key)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTokenParamsChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTokenParamsChange) GetSigners() []CUAddress {
+	return []CUAddress{msg.From}
+}
+
+func (msg MsgTokenParamsChange) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}
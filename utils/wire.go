@@ -10,6 +10,31 @@ func RegisterCodec(cdc *amino.Codec) {
 	//Must use cosmos-sdk.
 	cdc.RegisterInterface((*Msg)(nil), nil)
 	cdc.RegisterConcrete(MsgSend{}, "hbtcchain/transfer/MsgSend", nil)
+	cdc.RegisterConcrete(MsgCreateValidator{}, "hbtcchain/staking/MsgCreateValidator", nil)
+	cdc.RegisterConcrete(MsgEditValidator{}, "hbtcchain/staking/MsgEditValidator", nil)
+	cdc.RegisterConcrete(MsgDelegate{}, "hbtcchain/staking/MsgDelegate", nil)
+	cdc.RegisterConcrete(MsgUndelegate{}, "hbtcchain/staking/MsgUndelegate", nil)
+	cdc.RegisterConcrete(MsgBeginRedelegate{}, "hbtcchain/staking/MsgBeginRedelegate", nil)
+	cdc.RegisterConcrete(MsgUnjail{}, "hbtcchain/slashing/MsgUnjail", nil)
+	cdc.RegisterConcrete(MsgSetWithdrawAddress{}, "hbtcchain/distribution/MsgModifyWithdrawAddress", nil)
+	cdc.RegisterConcrete(MsgWithdrawDelegatorReward{}, "hbtcchain/distribution/MsgWithdrawDelegationReward", nil)
+	cdc.RegisterConcrete(MsgWithdrawValidatorCommission{}, "hbtcchain/distribution/MsgWithdrawValidatorCommission", nil)
+	cdc.RegisterInterface((*Content)(nil), nil)
+	cdc.RegisterConcrete(TextProposal{}, "hbtcchain/gov/TextProposal", nil)
+	cdc.RegisterConcrete(ParameterChangeProposal{}, "hbtcchain/params/ParameterChangeProposal", nil)
+	cdc.RegisterConcrete(MsgSubmitProposal{}, "hbtcchain/gov/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(MsgDeposit{}, "hbtcchain/gov/MsgDeposit", nil)
+	cdc.RegisterConcrete(MsgVote{}, "hbtcchain/gov/MsgVote", nil)
+	cdc.RegisterConcrete(MsgKeyGen{}, "hbtcchain/keygen/MsgKeyGen", nil)
+	cdc.RegisterConcrete(MsgWithdrawal{}, "hbtcchain/transfer/MsgWithdrawal", nil)
+	cdc.RegisterConcrete(MsgAddLiquidity{}, "hbtcchain/openswap/MsgAddLiquidity", nil)
+	cdc.RegisterConcrete(MsgRemoveLiquidity{}, "hbtcchain/openswap/MsgRemoveLiquidity", nil)
+	cdc.RegisterConcrete(MsgSwapExactIn{}, "hbtcchain/openswap/MsgSwapExactIn", nil)
+	cdc.RegisterConcrete(MsgSwapExactOut{}, "hbtcchain/openswap/MsgSwapExactOut", nil)
+	cdc.RegisterConcrete(MsgNewToken{}, "hbtcchain/token/MsgNewToken", nil)
+	cdc.RegisterConcrete(MsgInflateToken{}, "hbtcchain/token/MsgInflateToken", nil)
+	cdc.RegisterConcrete(MsgBurnToken{}, "hbtcchain/token/MsgBurnToken", nil)
+	cdc.RegisterConcrete(MsgTokenParamsChange{}, "hbtcchain/token/MsgTokenParamsChange", nil)
 }
 
 func init() {
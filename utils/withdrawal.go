@@ -0,0 +1,75 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TransferRoute = "transfer"
+
+// MsgWithdrawal - struct for withdrawing a token to an address of its external chain
+type MsgWithdrawal struct {
+	FromCU    CUAddress `json:"from_cu" yaml:"from_cu"`
+	ToAddress string    `json:"to_multisigned_address" yaml:"to_multisigned_address"`
+	Symbol    string    `json:"symbol" yaml:"symbol"`
+	OrderID   string    `json:"order_id" yaml:"order_id"`
+	Amount    sdk.Int   `json:"amount" yaml:"amount"`
+	GasFee    sdk.Int   `json:"gas_fee" yaml:"gas_fee"`
+}
+
+var _ Msg = MsgWithdrawal{}
+
+// NewMsgWithdrawal - construct a withdrawal msg.
+func NewMsgWithdrawal(fromCU CUAddress, toAddress, symbol, orderID string, amount, gasFee sdk.Int) MsgWithdrawal {
+	return MsgWithdrawal{
+		FromCU:    fromCU,
+		ToAddress: toAddress,
+		Symbol:    symbol,
+		OrderID:   orderID,
+		Amount:    amount,
+		GasFee:    gasFee,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgWithdrawal) Route() string { return TransferRoute }
+
+// Type Implements Msg.
+func (msg MsgWithdrawal) Type() string { return "withdrawal" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgWithdrawal) ValidateBasic() error {
+	if msg.FromCU.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+	if msg.ToAddress == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing to address")
+	}
+	if msg.OrderID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing order id")
+	}
+	if err := validateDenom(msg.Symbol); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if IsNilInt(msg.Amount) || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Amount inValid")
+	}
+	if IsNilInt(msg.GasFee) || msg.GasFee.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "GasFee inValid")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgWithdrawal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgWithdrawal) GetSigners() []CUAddress {
+	return []CUAddress{msg.FromCU}
+}
+
+func (msg MsgWithdrawal) GetInvolvedAddresses() []CUAddress {
+	return msg.GetSigners()
+}